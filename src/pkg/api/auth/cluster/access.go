@@ -0,0 +1,175 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package cluster
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	authorizationV1 "k8s.io/api/authorization/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// AccessReviewTTL is how long an access decision is cached for a user
+const AccessReviewTTL = time.Minute
+
+// User is the identity requests are impersonated as
+type User struct {
+	Name   string
+	Groups []string
+}
+
+type userKey struct{}
+
+// WithUser sets the user the request is impersonated as
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the user the request is impersonated as, ok is false if impersonation is not enabled
+func UserFromContext(ctx context.Context) (user User, ok bool) {
+	user, ok = ctx.Value(userKey{}).(User)
+	return user, ok
+}
+
+// accessKey identifies a cached access decision
+type accessKey struct {
+	user      string
+	groups    string
+	verb      string
+	group     string
+	resource  string
+	namespace string
+	name      string
+}
+
+// accessDecision is a cached access decision
+type accessDecision struct {
+	allowed bool
+	expires time.Time
+}
+
+// AccessReviewer checks whether users are allowed to access resources with SubjectAccessReviews and caches the decisions
+type AccessReviewer struct {
+	mutex     sync.Mutex
+	client    kubernetes.Interface
+	ttl       time.Duration
+	decisions map[accessKey]accessDecision
+	// prunedAt is when expired decisions were last removed, they are removed at most once per TTL
+	prunedAt time.Time
+}
+
+// NewAccessReviewer creates an access reviewer that caches decisions for the given TTL
+func NewAccessReviewer(client kubernetes.Interface, ttl time.Duration) *AccessReviewer {
+	return &AccessReviewer{
+		client:    client,
+		ttl:       ttl,
+		decisions: make(map[accessKey]accessDecision),
+	}
+}
+
+// Allowed returns whether the user may perform the verb on the resource, an empty namespace means cluster-wide
+// Failed reviews deny access and are not cached
+func (a *AccessReviewer) Allowed(ctx context.Context, user User, verb string, gvr schema.GroupVersionResource, namespace, name string) bool {
+	key := accessKey{
+		user:      user.Name,
+		groups:    strings.Join(user.Groups, "\n"),
+		verb:      verb,
+		group:     gvr.Group,
+		resource:  gvr.Resource,
+		namespace: namespace,
+		name:      name,
+	}
+
+	a.mutex.Lock()
+	decision, found := a.decisions[key]
+	a.mutex.Unlock()
+	if found && time.Now().Before(decision.expires) {
+		return decision.allowed
+	}
+
+	review := &authorizationV1.SubjectAccessReview{
+		Spec: authorizationV1.SubjectAccessReviewSpec{
+			User:   user.Name,
+			Groups: user.Groups,
+			ResourceAttributes: &authorizationV1.ResourceAttributes{
+				Verb:      verb,
+				Group:     gvr.Group,
+				Version:   gvr.Version,
+				Resource:  gvr.Resource,
+				Namespace: namespace,
+				Name:      name,
+			},
+		},
+	}
+
+	result, err := a.client.AuthorizationV1().SubjectAccessReviews().Create(ctx, review, metaV1.CreateOptions{})
+	if err != nil {
+		slog.Warn("Failed to review access", "user", user.Name, "verb", verb, "resource", gvr.String(), "error", err)
+		return false
+	}
+
+	now := time.Now()
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if now.Sub(a.prunedAt) >= a.ttl {
+		a.pruneExpired(now)
+	}
+	a.decisions[key] = accessDecision{allowed: result.Status.Allowed, expires: now.Add(a.ttl)}
+	return result.Status.Allowed
+}
+
+// pruneExpired removes expired decisions, the caller must hold the mutex
+// Decisions are kept for at most twice the TTL, without scanning every decision on each review
+func (a *AccessReviewer) pruneExpired(now time.Time) {
+	a.prunedAt = now
+	for key, decision := range a.decisions {
+		if now.After(decision.expires) {
+			delete(a.decisions, key)
+		}
+	}
+}
+
+// accessReviewer reviews access of impersonated users when set
+var accessReviewer *AccessReviewer
+
+// SetAccessReviewer enables filtering cached resources by the impersonated user's RBAC, nil disables it
+func SetAccessReviewer(a *AccessReviewer) {
+	accessReviewer = a
+}
+
+// ResourceAllowed returns whether the request's user may perform the verb on the resource
+// Requests that are not impersonated are always allowed, they are limited by the auth policy only
+func ResourceAllowed(ctx context.Context, verb string, gvr schema.GroupVersionResource, namespace, name string) bool {
+	user, ok := UserFromContext(ctx)
+	if !ok || accessReviewer == nil {
+		return true
+	}
+	return accessReviewer.Allowed(ctx, user, verb, gvr, namespace, name)
+}
+
+// claimStrings returns a string or list claim as a slice of strings
+func claimStrings(claim interface{}) []string {
+	switch c := claim.(type) {
+	case string:
+		return []string{c}
+	case []interface{}:
+		values := make([]string, 0, len(c))
+		for _, item := range c {
+			if value, ok := item.(string); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+	case []string:
+		return slices.Clone(c)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	authorizationV1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var secretsGVR = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+// newFakeReviewClient returns a clientset that allows the developers group to access team-a and counts the reviews
+func newFakeReviewClient(reviews *int) *fake.Clientset {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		*reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationV1.SubjectAccessReview)
+		if review.Spec.User == "broken" {
+			return true, nil, errors.New("review failed")
+		}
+		review.Status.Allowed = review.Spec.ResourceAttributes.Namespace == "team-a" && claimHasValue(review.Spec.Groups, "developers")
+		return true, review, nil
+	})
+	return clientset
+}
+
+func TestAccessReviewer(t *testing.T) {
+	var reviews int
+	reviewer := NewAccessReviewer(newFakeReviewClient(&reviews), time.Minute)
+	ctx := context.Background()
+	developer := User{Name: "doug", Groups: []string{"developers"}}
+	guest := User{Name: "gary", Groups: []string{"guests"}}
+
+	require.True(t, reviewer.Allowed(ctx, developer, "list", secretsGVR, "team-a", ""))
+	require.False(t, reviewer.Allowed(ctx, developer, "list", secretsGVR, "kube-system", ""))
+	require.False(t, reviewer.Allowed(ctx, guest, "list", secretsGVR, "team-a", ""))
+	require.Equal(t, 3, reviews)
+
+	// Decisions are cached per user
+	require.True(t, reviewer.Allowed(ctx, developer, "list", secretsGVR, "team-a", ""))
+	require.False(t, reviewer.Allowed(ctx, guest, "list", secretsGVR, "team-a", ""))
+	require.Equal(t, 3, reviews)
+
+	// Failed reviews deny access and are retried
+	broken := User{Name: "broken"}
+	require.False(t, reviewer.Allowed(ctx, broken, "list", secretsGVR, "team-a", ""))
+	require.False(t, reviewer.Allowed(ctx, broken, "list", secretsGVR, "team-a", ""))
+	require.Equal(t, 5, reviews)
+}
+
+func TestAccessReviewerTTL(t *testing.T) {
+	var reviews int
+	reviewer := NewAccessReviewer(newFakeReviewClient(&reviews), 0)
+	ctx := context.Background()
+	developer := User{Name: "doug", Groups: []string{"developers"}}
+
+	// Expired decisions are reviewed again
+	require.True(t, reviewer.Allowed(ctx, developer, "list", secretsGVR, "team-a", ""))
+	require.True(t, reviewer.Allowed(ctx, developer, "list", secretsGVR, "team-a", ""))
+	require.Equal(t, 2, reviews)
+}
+
+func TestAccessReviewerPrune(t *testing.T) {
+	var reviews int
+	reviewer := NewAccessReviewer(newFakeReviewClient(&reviews), time.Minute)
+	ctx := context.Background()
+	developer := User{Name: "doug", Groups: []string{"developers"}}
+	guest := User{Name: "gary", Groups: []string{"guests"}}
+
+	require.True(t, reviewer.Allowed(ctx, developer, "list", secretsGVR, "team-a", ""))
+	reviewer.mutex.Lock()
+	for key, decision := range reviewer.decisions {
+		decision.expires = time.Now().Add(-time.Second)
+		reviewer.decisions[key] = decision
+	}
+	reviewer.mutex.Unlock()
+
+	// Expired decisions are kept until the TTL has passed since the last prune
+	require.False(t, reviewer.Allowed(ctx, guest, "list", secretsGVR, "team-a", ""))
+	require.Len(t, reviewer.decisions, 2)
+
+	reviewer.mutex.Lock()
+	reviewer.prunedAt = time.Now().Add(-time.Minute)
+	reviewer.mutex.Unlock()
+	require.False(t, reviewer.Allowed(ctx, guest, "list", secretsGVR, "kube-system", ""))
+	require.Len(t, reviewer.decisions, 2)
+	require.Equal(t, 3, reviews)
+}
+
+func TestResourceAllowed(t *testing.T) {
+	var reviews int
+	SetAccessReviewer(NewAccessReviewer(newFakeReviewClient(&reviews), time.Minute))
+	defer SetAccessReviewer(nil)
+
+	// Requests without an impersonated user are not reviewed
+	require.True(t, ResourceAllowed(context.Background(), "list", secretsGVR, "kube-system", ""))
+	require.Equal(t, 0, reviews)
+
+	ctx := WithUser(context.Background(), User{Name: "doug", Groups: []string{"developers"}})
+	require.True(t, ResourceAllowed(ctx, "list", secretsGVR, "team-a", ""))
+	require.False(t, ResourceAllowed(ctx, "list", secretsGVR, "kube-system", ""))
+}
+
+func TestAuthorizeImpersonation(t *testing.T) {
+	config.ImpersonationEnabled = true
+	defer func() { config.ImpersonationEnabled = false }()
+
+	createToken := func(claims jwt.MapClaims) string {
+		tokenString, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		return tokenString
+	}
+
+	tests := []struct {
+		name           string
+		token          string
+		expectedStatus int
+		expectedUser   *User
+	}{
+		{
+			name:           "Subject and groups are impersonated",
+			token:          createToken(jwt.MapClaims{"sub": "doug", "groups": []string{"/UDS Core/Admin", "developers"}}),
+			expectedStatus: http.StatusOK,
+			expectedUser:   &User{Name: "doug", Groups: []string{"/UDS Core/Admin", "developers"}},
+		},
+		{
+			name:           "Missing subject",
+			token:          createToken(jwt.MapClaims{"groups": []string{"/UDS Core/Admin"}}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rr := httptest.NewRecorder()
+
+			authorizedReq, allowed := Authorize(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Equal(t, tt.expectedUser != nil, allowed)
+			if tt.expectedUser != nil {
+				user, ok := UserFromContext(authorizedReq.Context())
+				require.True(t, ok)
+				require.Equal(t, *tt.expectedUser, user)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package cluster
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// JWKSRefreshInterval is how long fetched keys are used before they are fetched again
+	JWKSRefreshInterval = time.Hour
+	// JWKSMinRefreshInterval limits how often an unknown key ID can trigger a fetch, e.g. after a key rotation
+	JWKSMinRefreshInterval = 30 * time.Second
+)
+
+// signingMethods are the algorithms accepted for verified tokens
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// JWKS fetches and caches the signing keys of an OIDC issuer
+type JWKS struct {
+	mutex      sync.Mutex
+	client     *http.Client
+	issuer     string
+	url        string
+	keys       map[string]crypto.PublicKey
+	fetchedAt  time.Time
+	attemptAt  time.Time
+	refresh    time.Duration
+	minRefresh time.Duration
+	// refreshing is closed when the fetch in progress, if any, completes
+	refreshing chan struct{}
+}
+
+// NewJWKS creates a key cache for the issuer, if url is empty it is discovered from the issuer's OpenID configuration
+func NewJWKS(issuer, url string) *JWKS {
+	return &JWKS{
+		client:     &http.Client{Timeout: 10 * time.Second},
+		issuer:     issuer,
+		url:        url,
+		refresh:    JWKSRefreshInterval,
+		minRefresh: JWKSMinRefreshInterval,
+	}
+}
+
+// Keyfunc returns the key a token was signed with, it implements jwt.Keyfunc
+// Keys are fetched again when they are stale or when the token uses an unknown key ID
+func (j *JWKS) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	j.mutex.Lock()
+	key, found := j.keys[kid]
+	stale := time.Since(j.fetchedAt) > j.refresh
+	refresh := (!found || stale) && (j.refreshing != nil || time.Since(j.attemptAt) > j.minRefresh)
+	j.mutex.Unlock()
+
+	if refresh {
+		j.refreshKeys()
+
+		j.mutex.Lock()
+		key, found = j.keys[kid]
+		j.mutex.Unlock()
+	}
+
+	if !found {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// refreshKeys fetches the issuer's current keys, callers arriving while a fetch is in progress wait for it instead
+// The issuer is called without holding the mutex so requests signed with fresh keys are never blocked by it
+func (j *JWKS) refreshKeys() {
+	j.mutex.Lock()
+	if refreshing := j.refreshing; refreshing != nil {
+		j.mutex.Unlock()
+		<-refreshing
+		return
+	}
+	// Another caller may have just fetched the keys
+	if time.Since(j.attemptAt) <= j.minRefresh {
+		j.mutex.Unlock()
+		return
+	}
+	refreshing := make(chan struct{})
+	j.refreshing = refreshing
+	j.attemptAt = time.Now()
+	url := j.url
+	j.mutex.Unlock()
+
+	keys, url, err := j.fetch(context.Background(), url)
+
+	j.mutex.Lock()
+	if err != nil {
+		// Keep using the keys we have if the issuer is unavailable
+		slog.Warn("Failed to refresh JWKS", "error", err)
+	} else {
+		j.keys = keys
+		j.url = url
+		j.fetchedAt = time.Now()
+	}
+	j.refreshing = nil
+	j.mutex.Unlock()
+	close(refreshing)
+}
+
+// fetch returns the issuer's current keys and the JWKS url, which is discovered if url is empty
+func (j *JWKS) fetch(ctx context.Context, url string) (map[string]crypto.PublicKey, string, error) {
+	if url == "" {
+		discovered, err := j.discover(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		url = discovered
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := j.getJSON(ctx, url, &set); err != nil {
+		return nil, "", err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		// Skip encryption keys
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			slog.Debug("Skipping unsupported JWK", "kid", jwk.Kid, "error", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, url, nil
+}
+
+// discover returns the jwks_uri from the issuer's OpenID configuration
+func (j *JWKS) discover(ctx context.Context) (string, error) {
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	url := strings.TrimSuffix(j.issuer, "/") + "/.well-known/openid-configuration"
+	if err := j.getJSON(ctx, url, &configuration); err != nil {
+		return "", err
+	}
+	if configuration.JWKSURI == "" {
+		return "", fmt.Errorf("openid configuration of %s has no jwks_uri", j.issuer)
+	}
+	return configuration.JWKSURI, nil
+}
+
+// getJSON fetches the url and decodes the JSON response into v
+func (j *JWKS) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// jsonWebKey is a public key in JWK format, only the fields for RSA and EC keys are supported
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey converts the JWK to a crypto public key
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// decodeBigInt decodes a base64url encoded big-endian integer
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key parameter: %w", err)
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// Verifier checks the signature and standard claims of tokens issued by an OIDC issuer
+type Verifier struct {
+	jwks   *JWKS
+	parser *jwt.Parser
+}
+
+// NewVerifier creates a verifier for tokens from the issuer, the audience is only checked if it is not empty
+func NewVerifier(jwks *JWKS, issuer, audience string) *Verifier {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30 * time.Second),
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+
+	return &Verifier{jwks: jwks, parser: jwt.NewParser(options...)}
+}
+
+// Parse verifies the token and returns its claims
+func (v *Verifier) Parse(tokenString string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.jwks.Keyfunc); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// verifier verifies tokens when set, otherwise tokens are trusted as validated by authservice
+var verifier *Verifier
+
+// SetVerifier enables signature and claim verification of tokens, nil disables it
+func SetVerifier(v *Verifier) {
+	verifier = v
+}
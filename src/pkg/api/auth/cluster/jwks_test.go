@@ -0,0 +1,324 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package cluster
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksServer serves an OpenID configuration and a JWKS whose keys can be rotated
+type jwksServer struct {
+	*httptest.Server
+	mutex   sync.Mutex
+	keys    []map[string]string
+	fetches int
+	// blocked, if set, holds JWKS responses until it is closed
+	blocked chan struct{}
+}
+
+func newJWKSServer(t *testing.T) *jwksServer {
+	s := &jwksServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		//nolint:errcheck
+		json.NewEncoder(w).Encode(map[string]string{"issuer": s.URL, "jwks_uri": s.URL + "/certs"})
+	})
+	mux.HandleFunc("/certs", func(w http.ResponseWriter, _ *http.Request) {
+		s.mutex.Lock()
+		blocked := s.blocked
+		s.mutex.Unlock()
+		if blocked != nil {
+			<-blocked
+		}
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.fetches++
+		//nolint:errcheck
+		json.NewEncoder(w).Encode(map[string]any{"keys": s.keys})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// setKeys replaces the served keys
+func (s *jwksServer) setKeys(keys ...map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.keys = keys
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) map[string]string {
+	return map[string]string{
+		"kid": kid,
+		"kty": "RSA",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PrivateKey) map[string]string {
+	return map[string]string{
+		"kid": kid,
+		"kty": "EC",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+		"y":   base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+	}
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	tokenString, err := token.SignedString(key)
+	require.NoError(t, err)
+	return tokenString
+}
+
+func TestVerifier(t *testing.T) {
+	server := newJWKSServer(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server.setKeys(rsaJWK("rsa-1", rsaKey), ecJWK("ec-1", ecKey))
+
+	// Discover the JWKS URL from the issuer
+	v := NewVerifier(NewJWKS(server.URL, ""), server.URL, "uds-runtime")
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    server.URL,
+			"aud":    "uds-runtime",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": []string{"/UDS Core/Admin"},
+		}
+	}
+	withClaim := func(name string, value any) jwt.MapClaims {
+		claims := validClaims()
+		claims[name] = value
+		return claims
+	}
+	unsigned, _ := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	tests := []struct {
+		name    string
+		token   string
+		isValid bool
+	}{
+		{
+			name:    "Valid RSA token",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims()),
+			isValid: true,
+		},
+		{
+			name:    "Valid EC token",
+			token:   signToken(t, jwt.SigningMethodES256, "ec-1", ecKey, validClaims()),
+			isValid: true,
+		},
+		{
+			name:  "Wrong signing key",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa-1", otherKey, validClaims()),
+		},
+		{
+			name:  "Unknown key ID",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa-2", otherKey, validClaims()),
+		},
+		{
+			name:  "Unsigned token",
+			token: unsigned,
+		},
+		{
+			name:  "Expired token",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, withClaim("exp", time.Now().Add(-time.Hour).Unix())),
+		},
+		{
+			name: "Missing expiry",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, func() jwt.MapClaims {
+				claims := validClaims()
+				delete(claims, "exp")
+				return claims
+			}()),
+		},
+		{
+			name:  "Not valid yet",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, withClaim("nbf", time.Now().Add(time.Hour).Unix())),
+		},
+		{
+			name:  "Wrong issuer",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, withClaim("iss", "https://evil.example.com")),
+		},
+		{
+			name:  "Wrong audience",
+			token: signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, withClaim("aud", "another-app")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := v.Parse(tt.token)
+			if tt.isValid {
+				require.NoError(t, err)
+				require.Equal(t, server.URL, claims["iss"])
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJWKSRotation(t *testing.T) {
+	server := newJWKSServer(t)
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server.setKeys(rsaJWK("old", oldKey))
+
+	jwks := NewJWKS(server.URL, server.URL+"/certs")
+	jwks.minRefresh = 0
+	v := NewVerifier(jwks, server.URL, "")
+	claims := jwt.MapClaims{"iss": server.URL, "exp": time.Now().Add(time.Hour).Unix()}
+
+	_, err = v.Parse(signToken(t, jwt.SigningMethodRS256, "old", oldKey, claims))
+	require.NoError(t, err)
+
+	// Cached keys are reused
+	_, err = v.Parse(signToken(t, jwt.SigningMethodRS256, "old", oldKey, claims))
+	require.NoError(t, err)
+	require.Equal(t, 1, server.fetches)
+
+	// A token signed with the rotated key triggers a refresh
+	server.setKeys(rsaJWK("new", newKey))
+	_, err = v.Parse(signToken(t, jwt.SigningMethodRS256, "new", newKey, claims))
+	require.NoError(t, err)
+	require.Equal(t, 2, server.fetches)
+
+	// The retired key is no longer trusted once it is stale
+	jwks.refresh = 0
+	_, err = v.Parse(signToken(t, jwt.SigningMethodRS256, "old", oldKey, claims))
+	require.Error(t, err)
+}
+
+func TestJWKSRefreshRateLimit(t *testing.T) {
+	server := newJWKSServer(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server.setKeys(rsaJWK("known", key))
+
+	jwks := NewJWKS(server.URL, server.URL+"/certs")
+	v := NewVerifier(jwks, server.URL, "")
+	claims := jwt.MapClaims{"iss": server.URL, "exp": time.Now().Add(time.Hour).Unix()}
+
+	// Unknown key IDs cannot be used to hammer the issuer
+	for range 3 {
+		_, err = v.Parse(signToken(t, jwt.SigningMethodRS256, "unknown", key, claims))
+		require.Error(t, err)
+	}
+	require.Equal(t, 1, server.fetches)
+}
+
+func TestJWKSSlowIssuer(t *testing.T) {
+	server := newJWKSServer(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server.setKeys(rsaJWK("known", key))
+
+	jwks := NewJWKS(server.URL, server.URL+"/certs")
+	jwks.minRefresh = 0
+	v := NewVerifier(jwks, server.URL, "")
+	claims := jwt.MapClaims{"iss": server.URL, "exp": time.Now().Add(time.Hour).Unix()}
+
+	_, err = v.Parse(signToken(t, jwt.SigningMethodRS256, "known", key, claims))
+	require.NoError(t, err)
+
+	// Tokens with unknown key IDs wait for the fetch from the slow issuer
+	blocked := make(chan struct{})
+	server.mutex.Lock()
+	server.blocked = blocked
+	server.mutex.Unlock()
+
+	var waiting sync.WaitGroup
+	for range 3 {
+		waiting.Add(1)
+		go func() {
+			defer waiting.Done()
+			_, err := v.Parse(signToken(t, jwt.SigningMethodRS256, "unknown", key, claims))
+			require.Error(t, err)
+		}()
+	}
+	require.Eventually(t, func() bool {
+		jwks.mutex.Lock()
+		defer jwks.mutex.Unlock()
+		return jwks.refreshing != nil
+	}, time.Second, 10*time.Millisecond)
+
+	// Tokens signed with a known key are not blocked meanwhile
+	_, err = v.Parse(signToken(t, jwt.SigningMethodRS256, "known", key, claims))
+	require.NoError(t, err)
+
+	close(blocked)
+	waiting.Wait()
+}
+
+func TestAuthorizeWithVerifier(t *testing.T) {
+	server := newJWKSServer(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server.setKeys(rsaJWK("key", key))
+
+	SetVerifier(NewVerifier(NewJWKS(server.URL, ""), server.URL, ""))
+	defer SetVerifier(nil)
+
+	claims := jwt.MapClaims{"iss": server.URL, "exp": time.Now().Add(time.Hour).Unix(), "groups": []string{"/UDS Core/Admin"}}
+	unsigned, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	tests := []struct {
+		name           string
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "Signed token",
+			token:          signToken(t, jwt.SigningMethodRS256, "key", key, claims),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unsigned token is rejected",
+			token:          unsigned,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rr := httptest.NewRecorder()
+
+			_, allowed := Authorize(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Equal(t, tt.expectedStatus == http.StatusOK, allowed)
+		})
+	}
+}
@@ -7,48 +7,66 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var allowedGroups = []string{
-	"/UDS Core/Admin",
-	"/UDS Core/Auditor",
-}
-
-// ValidateJWT checks if the request has a valid JWT token with the required groups.
-func ValidateJWT(w http.ResponseWriter, r *http.Request) bool {
+// Authorize checks if the request has a valid JWT token whose groups or claims allow the request under the policy.
+// If the allowing roles are namespace scoped, the returned request carries the namespaces it is limited to.
+func Authorize(w http.ResponseWriter, r *http.Request) (*http.Request, bool) {
 	authHeader := r.Header.Get("Authorization")
 
 	if authHeader == "" {
 		http.Error(w, "Missing Authorization header", http.StatusUnauthorized)
-		return false
+		return r, false
 	}
 
 	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-	// parse the JWT token without validation (authservice will validate it, we only need the groups here)
-	token, _, err := jwt.NewParser(jwt.WithoutClaimsValidation()).ParseUnverified(tokenString, jwt.Claims(jwt.MapClaims{}))
+	claims, err := parseClaims(tokenString)
 	if err != nil {
 		http.Error(w, "Invalid token", http.StatusUnauthorized)
-		return false
-	}
-
-	// Check if the token contains a "groups" claim
-	if groups, ok := token.Claims.(jwt.MapClaims)["groups"].([]interface{}); ok {
-		// Check if any of the token's groups match the allowed groups
-		for _, group := range groups {
-			for _, allowedGroup := range allowedGroups {
-				if group == allowedGroup {
-					// Group is allowed
-					return true
-				}
-			}
-		}
-		// If we reach here, no matching group was found
+		return r, false
+	}
+
+	// Check if the token contains a "groups" claim, it is not needed when a claim binding applies to the token
+	if _, ok := claims["groups"].([]interface{}); !ok && !policy.bindsClaims(claims) {
+		http.Error(w, "Invalid token claims", http.StatusUnauthorized)
+		return r, false
+	}
+
+	allowed, namespaces := policy.Authorize(claims, requestVerb(r), r.URL.Path)
+	if !allowed {
 		http.Error(w, "Insufficient permissions", http.StatusForbidden)
-		return false
+		return r, false
+	}
+
+	if namespaces != nil {
+		r = r.WithContext(WithNamespaceScope(r.Context(), namespaces))
 	}
 
-	http.Error(w, "Invalid token claims", http.StatusUnauthorized)
-	return false
+	// Act as the token's subject so Kubernetes RBAC applies to the user rather than the service account
+	if config.ImpersonationEnabled {
+		subject, _ := claims["sub"].(string)
+		if subject == "" {
+			http.Error(w, "Invalid token claims", http.StatusUnauthorized)
+			return r, false
+		}
+		r = r.WithContext(WithUser(r.Context(), User{Name: subject, Groups: claimStrings(claims["groups"])}))
+	}
+	return r, true
+}
+
+// parseClaims returns the token's claims, verifying it first if verification is enabled
+func parseClaims(tokenString string) (jwt.MapClaims, error) {
+	if verifier != nil {
+		return verifier.Parse(tokenString)
+	}
+
+	// parse the JWT token without validation (authservice will validate it, we only need the claims here)
+	token, _, err := jwt.NewParser(jwt.WithoutClaimsValidation()).ParseUnverified(tokenString, jwt.Claims(jwt.MapClaims{}))
+	if err != nil {
+		return nil, err
+	}
+	return token.Claims.(jwt.MapClaims), nil
 }
@@ -12,7 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestValidateJWT(t *testing.T) {
+func TestAuthorize(t *testing.T) {
 	// Helper function to create a JWT token without signing
 	createToken := func(groups []string) string {
 		claims := jwt.MapClaims{
@@ -72,14 +72,97 @@ func TestValidateJWT(t *testing.T) {
 			rr := httptest.NewRecorder()
 
 			// Call the function directly
-			result := ValidateJWT(rr, req)
+			_, result := Authorize(rr, req)
 
 			// Check the status code
 			require.Equal(t, tt.expectedStatus, rr.Code, "handler returned wrong status code")
 
 			// Check the return value
 			expectedResult := tt.expectedStatus == http.StatusOK
-			require.Equal(t, expectedResult, result, "ValidateJWT returned unexpected result")
+			require.Equal(t, expectedResult, result, "Authorize returned unexpected result")
+		})
+	}
+}
+
+func TestAuthorizeMutations(t *testing.T) {
+	createToken := func(groups []string) string {
+		claims := jwt.MapClaims{
+			"groups": groups,
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+		tokenString, _ := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		return tokenString
+	}
+
+	tests := []struct {
+		name           string
+		token          string
+		method         string
+		path           string
+		upgrade        string
+		expectedStatus int
+	}{
+		{
+			name:           "Admin can delete",
+			token:          createToken([]string{"/UDS Core/Admin"}),
+			method:         http.MethodDelete,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Auditor is read-only",
+			token:          createToken([]string{"/UDS Core/Auditor"}),
+			method:         http.MethodDelete,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Admin can exec",
+			token:          createToken([]string{"/UDS Core/Admin"}),
+			method:         http.MethodGet,
+			upgrade:        "websocket",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Auditor cannot exec",
+			token:          createToken([]string{"/UDS Core/Auditor"}),
+			method:         http.MethodGet,
+			upgrade:        "websocket",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Auditor cannot exec with several upgrade protocols",
+			token:          createToken([]string{"/UDS Core/Auditor"}),
+			method:         http.MethodGet,
+			path:           "/api/v1/resources/workloads/pods/1/exec",
+			upgrade:        "h2c, websocket",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Missing token",
+			token:          "",
+			method:         http.MethodDelete,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path
+			if path == "" {
+				path = "/api/v1/resources/workloads/pods/1"
+			}
+			req, _ := http.NewRequest(tt.method, path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			if tt.upgrade != "" {
+				req.Header.Set("Upgrade", tt.upgrade)
+			}
+			rr := httptest.NewRecorder()
+
+			_, result := Authorize(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code, "handler returned wrong status code")
+			require.Equal(t, tt.expectedStatus == http.StatusOK, result, "Authorize returned unexpected result")
 		})
 	}
 }
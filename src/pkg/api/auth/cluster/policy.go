@@ -0,0 +1,304 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Verbs a rule can grant, requests are mapped to a verb by their method
+const (
+	VerbGet    = "get"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+	// VerbExec is used for WebSocket upgrades, i.e. interactive sessions such as pod exec
+	VerbExec = "exec"
+	// VerbAll matches every verb
+	VerbAll = "*"
+)
+
+// Policy maps JWT groups and claims to roles, and roles to the routes and verbs they may use
+type Policy struct {
+	Roles    map[string]Role `json:"roles"`
+	Bindings []Binding       `json:"bindings"`
+}
+
+// Role is a named set of rules, a request is allowed if any rule of any bound role allows it
+type Role struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule grants verbs on a set of paths, optionally limited to some namespaces
+type Rule struct {
+	// Paths are matched segment by segment, * matches a single segment and a trailing ** matches the rest of the path
+	Paths []string `json:"paths"`
+	Verbs []string `json:"verbs"`
+	// Namespaces limits the resources returned or changed to these namespaces, empty means all namespaces
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Binding assigns a role to tokens with any of the groups, or with all of the claims
+type Binding struct {
+	Role   string            `json:"role"`
+	Groups []string          `json:"groups,omitempty"`
+	Claims map[string]string `json:"claims,omitempty"`
+}
+
+// DefaultPolicy lets Auditors read everything and Admins do anything
+// The operator role can additionally restart, scale and suspend workloads but is not bound to any group
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Roles: map[string]Role{
+			"viewer": {
+				Rules: []Rule{
+					{Paths: []string{"/**"}, Verbs: []string{VerbGet}},
+				},
+			},
+			"operator": {
+				Rules: []Rule{
+					{Paths: []string{"/**"}, Verbs: []string{VerbGet}},
+					{
+						Paths: []string{
+							"/api/v1/resources/workloads/*/*/restart",
+							"/api/v1/resources/workloads/*/*/scale",
+							"/api/v1/resources/workloads/cronjobs/*/suspend",
+							"/api/v1/resources/workloads/cronjobs/*/resume",
+						},
+						Verbs: []string{VerbCreate},
+					},
+				},
+			},
+			"admin": {
+				Rules: []Rule{
+					{Paths: []string{"/**"}, Verbs: []string{VerbAll}},
+				},
+			},
+		},
+		Bindings: []Binding{
+			{Role: "admin", Groups: []string{"/UDS Core/Admin"}},
+			{Role: "viewer", Groups: []string{"/UDS Core/Auditor"}},
+		},
+	}
+}
+
+// policy is the policy used to authorize in-cluster requests
+var policy = DefaultPolicy()
+
+// SetPolicy replaces the policy used to authorize in-cluster requests
+func SetPolicy(p *Policy) {
+	policy = p
+}
+
+// LoadPolicy reads and validates a YAML or JSON policy file, e.g. one mounted from a ConfigMap
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth policy: %w", err)
+	}
+
+	var p Policy
+	if err := yaml.UnmarshalStrict(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse auth policy: %w", err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Validate ensures every binding refers to a defined role and every rule has paths and verbs
+func (p *Policy) Validate() error {
+	for name, role := range p.Roles {
+		for i, rule := range role.Rules {
+			if len(rule.Paths) == 0 || len(rule.Verbs) == 0 {
+				return fmt.Errorf("rule %d of role %s must have paths and verbs", i, name)
+			}
+		}
+	}
+
+	for i, binding := range p.Bindings {
+		if _, ok := p.Roles[binding.Role]; !ok {
+			return fmt.Errorf("binding %d refers to undefined role %s", i, binding.Role)
+		}
+		if len(binding.Groups) == 0 && len(binding.Claims) == 0 {
+			return fmt.Errorf("binding %d must have groups or claims", i)
+		}
+	}
+
+	return nil
+}
+
+// Authorize returns whether the claims allow the verb on the path
+// If the allowing rules are namespace scoped, the namespaces they are limited to are returned, otherwise nil
+func (p *Policy) Authorize(claims map[string]interface{}, verb, path string) (allowed bool, namespaces []string) {
+	scoped := true
+	for _, binding := range p.Bindings {
+		if !binding.matches(claims) {
+			continue
+		}
+
+		for _, rule := range p.Roles[binding.Role].Rules {
+			if !rule.allows(verb, path) {
+				continue
+			}
+
+			allowed = true
+			if len(rule.Namespaces) == 0 {
+				scoped = false
+			}
+			namespaces = append(namespaces, rule.Namespaces...)
+		}
+	}
+
+	if !allowed || !scoped {
+		return allowed, nil
+	}
+
+	slices.Sort(namespaces)
+	return true, slices.Compact(namespaces)
+}
+
+// bindsClaims returns whether a binding applies to the claims through its claims rather than groups
+func (p *Policy) bindsClaims(claims map[string]interface{}) bool {
+	for _, binding := range p.Bindings {
+		if len(binding.Claims) > 0 && binding.matchesClaims(claims) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns whether the claims have any of the binding's groups or all of its claims
+func (b Binding) matches(claims map[string]interface{}) bool {
+	for _, group := range b.Groups {
+		if claimHasValue(claims["groups"], group) {
+			return true
+		}
+	}
+
+	return len(b.Claims) > 0 && b.matchesClaims(claims)
+}
+
+// matchesClaims returns whether the claims have all of the binding's claims
+func (b Binding) matchesClaims(claims map[string]interface{}) bool {
+	for name, value := range b.Claims {
+		if !claimHasValue(claims[name], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// claimHasValue returns whether a string claim equals the value or a list claim contains it
+func claimHasValue(claim interface{}, value string) bool {
+	switch c := claim.(type) {
+	case string:
+		return c == value
+	case []interface{}:
+		for _, item := range c {
+			if item == value {
+				return true
+			}
+		}
+	case []string:
+		return slices.Contains(c, value)
+	}
+	return false
+}
+
+// allows returns whether the rule grants the verb on the path
+func (r Rule) allows(verb, path string) bool {
+	if !slices.Contains(r.Verbs, verb) && !slices.Contains(r.Verbs, VerbAll) {
+		return false
+	}
+
+	for _, pattern := range r.Paths {
+		if matchPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath matches a path against a pattern segment by segment
+func matchPath(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, segment := range patternSegments {
+		if segment == "**" && i == len(patternSegments)-1 {
+			return true
+		}
+		if i >= len(pathSegments) {
+			return false
+		}
+		if segment != "*" && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return len(patternSegments) == len(pathSegments)
+}
+
+// requestVerb maps the request to the verb it needs
+// Exec routes need exec whatever their headers, as do WebSocket upgrades to any other route
+func requestVerb(r *http.Request) string {
+	if strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/exec") || upgradesToWebSocket(r) {
+		return VerbExec
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		return VerbCreate
+	case http.MethodPut, http.MethodPatch:
+		return VerbUpdate
+	case http.MethodDelete:
+		return VerbDelete
+	default:
+		return VerbGet
+	}
+}
+
+// upgradesToWebSocket returns whether any token of the Upgrade headers is websocket
+// The WebSocket upgrader accepts a list of protocols, e.g. "h2c, websocket", so the whole list is checked
+func upgradesToWebSocket(r *http.Request) bool {
+	for _, value := range r.Header.Values("Upgrade") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "websocket") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type namespaceScopeKey struct{}
+
+// WithNamespaceScope limits the resources visible to the request to the given namespaces
+func WithNamespaceScope(ctx context.Context, namespaces []string) context.Context {
+	return context.WithValue(ctx, namespaceScopeKey{}, namespaces)
+}
+
+// NamespaceScope returns the namespaces the request is limited to, scoped is false if it may access all namespaces
+func NamespaceScope(ctx context.Context) (namespaces []string, scoped bool) {
+	namespaces, scoped = ctx.Value(namespaceScopeKey{}).([]string)
+	return namespaces, scoped
+}
+
+// NamespaceAllowed returns whether the request may access resources in the namespace
+// Cluster scoped resources, with an empty namespace, are only visible to requests that are not namespace scoped
+func NamespaceAllowed(ctx context.Context, namespace string) bool {
+	namespaces, scoped := NamespaceScope(ctx)
+	return !scoped || slices.Contains(namespaces, namespace)
+}
@@ -0,0 +1,262 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `
+roles:
+  viewer:
+    rules:
+      - paths: ["/**"]
+        verbs: ["get"]
+  team-operator:
+    rules:
+      - paths: ["/api/v1/resources/**"]
+        verbs: ["get"]
+        namespaces: ["team-a", "team-b"]
+      - paths: ["/api/v1/resources/workloads/deployments/*/restart"]
+        verbs: ["create"]
+        namespaces: ["team-a"]
+bindings:
+  - role: viewer
+    groups: ["/UDS Core/Auditor"]
+  - role: team-operator
+    claims:
+      team: platform
+`
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		matches bool
+	}{
+		{"/**", "/", true},
+		{"/**", "/api/v1/resources/nodes", true},
+		{"/api/v1/resources/**", "/api/v1/resources/nodes/1", true},
+		{"/api/v1/resources/**", "/api/v1/monitor/pepr", false},
+		{"/api/v1/resources/workloads/*/*/restart", "/api/v1/resources/workloads/deployments/1/restart", true},
+		{"/api/v1/resources/workloads/*/*/restart", "/api/v1/resources/workloads/deployments/1/scale", false},
+		{"/api/v1/resources/workloads/*/*/restart", "/api/v1/resources/workloads/deployments/1", false},
+		{"/api/v1/resources/nodes", "/api/v1/resources/nodes/1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			require.Equal(t, tt.matches, matchPath(tt.pattern, tt.path))
+		})
+	}
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0600))
+	p, err := LoadPolicy(path)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		claims             map[string]interface{}
+		verb               string
+		path               string
+		expectedAllowed    bool
+		expectedNamespaces []string
+	}{
+		{
+			name:            "Group bound viewer can read",
+			claims:          map[string]interface{}{"groups": []interface{}{"/UDS Core/Auditor"}},
+			verb:            VerbGet,
+			path:            "/api/v1/resources/nodes",
+			expectedAllowed: true,
+		},
+		{
+			name:            "Group bound viewer cannot delete",
+			claims:          map[string]interface{}{"groups": []interface{}{"/UDS Core/Auditor"}},
+			verb:            VerbDelete,
+			path:            "/api/v1/resources/workloads/pods/1",
+			expectedAllowed: false,
+		},
+		{
+			name:               "Claim bound operator reads within its namespaces",
+			claims:             map[string]interface{}{"groups": []interface{}{}, "team": "platform"},
+			verb:               VerbGet,
+			path:               "/api/v1/resources/workloads/pods",
+			expectedAllowed:    true,
+			expectedNamespaces: []string{"team-a", "team-b"},
+		},
+		{
+			name:               "Claim bound operator restarts within its namespace",
+			claims:             map[string]interface{}{"groups": []interface{}{}, "team": "platform"},
+			verb:               VerbCreate,
+			path:               "/api/v1/resources/workloads/deployments/1/restart",
+			expectedAllowed:    true,
+			expectedNamespaces: []string{"team-a"},
+		},
+		{
+			name:            "Claim bound operator cannot scale",
+			claims:          map[string]interface{}{"groups": []interface{}{}, "team": "platform"},
+			verb:            VerbCreate,
+			path:            "/api/v1/resources/workloads/deployments/1/scale",
+			expectedAllowed: false,
+		},
+		{
+			name:            "Unscoped role lifts the namespace scope",
+			claims:          map[string]interface{}{"groups": []interface{}{"/UDS Core/Auditor"}, "team": "platform"},
+			verb:            VerbGet,
+			path:            "/api/v1/resources/workloads/pods",
+			expectedAllowed: true,
+		},
+		{
+			name:            "Unbound claims",
+			claims:          map[string]interface{}{"groups": []interface{}{"guest"}, "team": "other"},
+			verb:            VerbGet,
+			path:            "/api/v1/resources/nodes",
+			expectedAllowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, namespaces := p.Authorize(tt.claims, tt.verb, tt.path)
+			require.Equal(t, tt.expectedAllowed, allowed)
+			require.Equal(t, tt.expectedNamespaces, namespaces)
+		})
+	}
+}
+
+func TestRequestVerb(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		upgrade  []string
+		expected string
+	}{
+		{name: "Read", method: http.MethodGet, path: "/api/v1/resources/workloads/pods/1", expected: VerbGet},
+		{name: "Delete", method: http.MethodDelete, path: "/api/v1/resources/workloads/pods/1", expected: VerbDelete},
+		{name: "WebSocket upgrade", method: http.MethodGet, path: "/api/v1/resources/workloads/pods/1", upgrade: []string{"websocket"}, expected: VerbExec},
+		{name: "Upgrade with several protocols", method: http.MethodGet, path: "/api/v1/resources/workloads/pods/1", upgrade: []string{"h2c, WebSocket"}, expected: VerbExec},
+		{name: "Several Upgrade headers", method: http.MethodGet, path: "/api/v1/resources/workloads/pods/1", upgrade: []string{"h2c", "websocket"}, expected: VerbExec},
+		{name: "Other upgrade", method: http.MethodGet, path: "/api/v1/resources/workloads/pods/1", upgrade: []string{"h2c"}, expected: VerbGet},
+		{name: "Exec route without upgrade", method: http.MethodGet, path: "/api/v1/resources/workloads/pods/1/exec", expected: VerbExec},
+		{name: "Exec route with trailing slash", method: http.MethodGet, path: "/api/v1/resources/workloads/pods/1/exec/", expected: VerbExec},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			for _, upgrade := range tt.upgrade {
+				req.Header.Add("Upgrade", upgrade)
+			}
+			require.Equal(t, tt.expected, requestVerb(req))
+		})
+	}
+}
+
+func TestLoadPolicyInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{
+			name:   "Undefined role",
+			policy: "roles: {}\nbindings:\n  - role: admin\n    groups: [\"admins\"]\n",
+		},
+		{
+			name:   "Binding without subjects",
+			policy: "roles:\n  admin:\n    rules:\n      - paths: [\"/**\"]\n        verbs: [\"*\"]\nbindings:\n  - role: admin\n",
+		},
+		{
+			name:   "Rule without verbs",
+			policy: "roles:\n  admin:\n    rules:\n      - paths: [\"/**\"]\nbindings: []\n",
+		},
+		{
+			name:   "Unknown field",
+			policy: "roles: {}\nbindings: []\nusers: []\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "policy.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.policy), 0600))
+			_, err := LoadPolicy(path)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestAuthorizeNamespaceScope(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0600))
+	p, err := LoadPolicy(path)
+	require.NoError(t, err)
+
+	SetPolicy(p)
+	defer SetPolicy(DefaultPolicy())
+
+	token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{"groups": []string{}, "team": "platform"})
+	tokenString, _ := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/resources/workloads/pods", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+
+	scopedReq, allowed := Authorize(httptest.NewRecorder(), req)
+	require.True(t, allowed)
+
+	namespaces, scoped := NamespaceScope(scopedReq.Context())
+	require.True(t, scoped)
+	require.Equal(t, []string{"team-a", "team-b"}, namespaces)
+	require.True(t, NamespaceAllowed(scopedReq.Context(), "team-a"))
+	require.False(t, NamespaceAllowed(scopedReq.Context(), "kube-system"))
+	require.False(t, NamespaceAllowed(scopedReq.Context(), ""))
+
+	// Requests without a scope can access every namespace
+	require.True(t, NamespaceAllowed(context.Background(), "kube-system"))
+}
+
+func TestAuthorizeClaimsWithoutGroups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0600))
+	p, err := LoadPolicy(path)
+	require.NoError(t, err)
+
+	SetPolicy(p)
+	defer SetPolicy(DefaultPolicy())
+
+	tests := []struct {
+		name           string
+		claims         jwt.MapClaims
+		expectedStatus int
+	}{
+		{name: "Claim binding applies", claims: jwt.MapClaims{"team": "platform"}, expectedStatus: http.StatusOK},
+		{name: "No claim binding applies", claims: jwt.MapClaims{"team": "other"}, expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := jwt.NewWithClaims(jwt.SigningMethodNone, tt.claims)
+			tokenString, _ := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/resources/workloads/pods", nil)
+			req.Header.Set("Authorization", "Bearer "+tokenString)
+			rr := httptest.NewRecorder()
+
+			_, allowed := Authorize(rr, req)
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Equal(t, tt.expectedStatus == http.StatusOK, allowed)
+		})
+	}
+}
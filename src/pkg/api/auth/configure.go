@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
 )
 
@@ -47,9 +48,61 @@ func Configure() {
 	if inClusterAuthEnabled {
 		config.InClusterAuthEnabled = inClusterAuthEnabled
 		slog.Info("In-cluster auth enabled")
+		configurePolicy()
+		configureVerification()
+		configureImpersonation()
 	}
 }
 
+// configureImpersonation makes requests act as the JWT subject and groups if IMPERSONATION_ENABLED is true
+func configureImpersonation() {
+	impersonationEnabled, err := strconv.ParseBool(strings.ToLower(os.Getenv("IMPERSONATION_ENABLED")))
+	if err != nil || !impersonationEnabled {
+		return
+	}
+
+	config.ImpersonationEnabled = true
+	slog.Info("Impersonation enabled")
+}
+
+// configureVerification enables JWT signature verification against the OIDC issuer's JWKS if JWT_VERIFICATION_ENABLED is true
+// Without it, tokens are trusted as validated by the authservice sidecar
+func configureVerification() {
+	verificationEnabled, err := strconv.ParseBool(strings.ToLower(os.Getenv("JWT_VERIFICATION_ENABLED")))
+	if err != nil || !verificationEnabled {
+		return
+	}
+
+	issuer := os.Getenv("OIDC_ISSUER_URL")
+	if issuer == "" {
+		slog.Error("OIDC_ISSUER_URL is required when JWT verification is enabled")
+		os.Exit(1)
+	}
+
+	// The JWKS URL is discovered from the issuer unless it is set explicitly
+	jwks := cluster.NewJWKS(issuer, os.Getenv("OIDC_JWKS_URL"))
+	cluster.SetVerifier(cluster.NewVerifier(jwks, issuer, os.Getenv("OIDC_AUDIENCE")))
+	slog.Info("JWT verification enabled", "issuer", issuer)
+}
+
+// configurePolicy loads the in-cluster auth policy from the file in AUTH_POLICY_FILE, e.g. a mounted ConfigMap
+// If no file is set, the default policy is used
+func configurePolicy() {
+	policyFile := os.Getenv("AUTH_POLICY_FILE")
+	if policyFile == "" {
+		slog.Info("Using the default auth policy")
+		return
+	}
+
+	policy, err := cluster.LoadPolicy(policyFile)
+	if err != nil {
+		slog.Error("Failed to load auth policy", "file", policyFile, "error", err)
+		os.Exit(1)
+	}
+	cluster.SetPolicy(policy)
+	slog.Info("Loaded auth policy", "file", policyFile)
+}
+
 // Very limited special chars for git / basic auth
 // https://owasp.org/www-community/password-special-characters has complete list of safe chars.
 const randomStringChars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ~-"
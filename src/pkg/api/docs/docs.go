@@ -28,6 +28,65 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/contexts": {
+            "get": {
+                "description": "Get the kubeconfig contexts and the one the runtime is connected to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contexts"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/session.ContextsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/contexts/switch": {
+            "post": {
+                "description": "Switch the runtime to another kubeconfig context. Open streams end with a cluster-switched event and should be reopened.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contexts"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the kubeconfig context",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/session.ContextsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing context name or running in-cluster",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Context not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/resources/cluster-ops/hpas": {
             "get": {
                 "description": "Get HPAs",
@@ -36,7 +95,10 @@ const docTemplate = `{
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -68,7 +130,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -80,14 +213,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/hpas/{uid}": {
+        "/api/v1/resources/cluster-ops/hpas/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get HPA by UID",
+                "description": "Get HPA by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -95,31 +232,54 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get hpa by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -131,24 +291,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/limit-ranges": {
+        "/api/v1/resources/cluster-ops/hpas/{uid}": {
             "get": {
-                "description": "Get LimitRanges",
+                "description": "Get HPA by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get hpa by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -171,7 +361,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -181,14 +371,9 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/cluster-ops/limit-ranges/{uid}": {
-            "get": {
-                "description": "Get LimitRange by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete HPA by UID",
                 "produces": [
                     "application/json"
                 ],
@@ -198,32 +383,15 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get limit-range by uid",
+                        "description": "HPA uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -234,15 +402,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/mutatingwebhooks": {
+        "/api/v1/resources/cluster-ops/limit-ranges": {
             "get": {
-                "description": "Get MutatingWebhooks",
+                "description": "Get LimitRanges",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -274,7 +445,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -286,14 +528,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/mutatingwebhooks/{uid}": {
+        "/api/v1/resources/cluster-ops/limit-ranges/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get MutatingWebhook by UID",
+                "description": "Get LimitRange by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -301,31 +547,54 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get mutatingwebhook by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -337,26 +606,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/poddisruptionbudgets": {
+        "/api/v1/resources/cluster-ops/limit-ranges/{uid}": {
             "get": {
-                "description": "Get PodDisruptionBudgets",
+                "description": "Get LimitRange by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
-                    },
+                        "type": "string",
+                        "description": "Get limit-range by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
                         "description": "Send the data in dense format",
@@ -377,7 +676,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -387,14 +686,9 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/cluster-ops/poddisruptionbudgets/{uid}": {
-            "get": {
-                "description": "Get PodDisruptionBudget by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete LimitRange by UID",
                 "produces": [
                     "application/json"
                 ],
@@ -404,32 +698,15 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get poddisruptionbudget by uid",
+                        "description": "LimitRange uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -440,15 +717,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/priority-classes": {
+        "/api/v1/resources/cluster-ops/mutatingwebhooks": {
             "get": {
-                "description": "Get PriorityClasses",
+                "description": "Get MutatingWebhooks",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -480,7 +760,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -492,14 +843,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/priority-classes/{uid}": {
+        "/api/v1/resources/cluster-ops/mutatingwebhooks/name/{name}": {
             "get": {
-                "description": "Get PriorityClass by UID",
+                "description": "Get MutatingWebhook by name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -507,31 +862,47 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get priority-class by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -543,24 +914,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/resource-quotas": {
+        "/api/v1/resources/cluster-ops/mutatingwebhooks/{uid}": {
             "get": {
-                "description": "Get ResourceQuotas",
+                "description": "Get MutatingWebhook by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get mutatingwebhook by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -583,7 +984,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -593,14 +994,9 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/cluster-ops/resource-quotas/{uid}": {
-            "get": {
-                "description": "Get ResourceQuota by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete MutatingWebhook by UID",
                 "produces": [
                     "application/json"
                 ],
@@ -610,32 +1006,15 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get resource-quota by uid",
+                        "description": "MutatingWebhook uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -646,15 +1025,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/runtime-classes": {
+        "/api/v1/resources/cluster-ops/poddisruptionbudgets": {
             "get": {
-                "description": "Get RuntimeClasses",
+                "description": "Get PodDisruptionBudgets",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -686,7 +1068,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -698,14 +1151,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/runtime-classes/{uid}": {
+        "/api/v1/resources/cluster-ops/poddisruptionbudgets/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get RuntimeClass by UID",
+                "description": "Get PodDisruptionBudget by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
@@ -713,31 +1170,54 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get runtime-class by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -749,24 +1229,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/cluster-ops/validatingwebhooks": {
+        "/api/v1/resources/cluster-ops/poddisruptionbudgets/{uid}": {
             "get": {
-                "description": "Get ValidatingWebhooks",
+                "description": "Get PodDisruptionBudget by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "cluster ops"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get poddisruptionbudget by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -789,7 +1299,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -799,14 +1309,9 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/cluster-ops/validatingwebhooks/{uid}": {
-            "get": {
-                "description": "Get ValidatingWebhook by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete PodDisruptionBudget by UID",
                 "produces": [
                     "application/json"
                 ],
@@ -816,32 +1321,15 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get validatingwebhook by uid",
+                        "description": "PodDisruptionBudget uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -852,18 +1340,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/configs/configmaps": {
+        "/api/v1/resources/cluster-ops/priority-classes": {
             "get": {
-                "description": "Get ConfigMaps",
+                "description": "Get PriorityClasses",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "configs"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
@@ -892,110 +1383,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/api/v1/resources/configs/configmaps/{uid}": {
-            "get": {
-                "description": "Get ConfigMap by UID",
-                "consumes": [
-                    "text/html"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "configs"
-                ],
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Get configmap by uid",
-                        "name": "uid",
-                        "in": "path"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Sort order",
+                        "name": "order",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/api/v1/resources/configs/secrets": {
-            "get": {
-                "description": "Get Secrets",
-                "consumes": [
-                    "text/html"
-                ],
-                "produces": [
-                    "text/event-stream",
-                    "application/json"
-                ],
-                "tags": [
-                    "configs"
-                ],
-                "parameters": [
+                    },
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1007,46 +1466,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/configs/secrets/{uid}": {
+        "/api/v1/resources/cluster-ops/priority-classes/name/{name}": {
             "get": {
-                "description": "Get Secret by UID",
+                "description": "Get PriorityClass by name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "configs"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get secret by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1058,24 +1537,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/configs/uds-exemptions": {
+        "/api/v1/resources/cluster-ops/priority-classes/{uid}": {
             "get": {
-                "description": "Get UDS Exemptions",
+                "description": "Get PriorityClass by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "configs"
+                    "cluster ops"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get priority-class by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -1098,7 +1607,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1108,49 +1617,27 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/configs/uds-exemptions/{uid}": {
-            "get": {
-                "description": "Get UDS Exemption by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete PriorityClass by UID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "configs"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get uds exemption by uid",
+                        "description": "PriorityClass uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -1161,18 +1648,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/configs/uds-packages": {
+        "/api/v1/resources/cluster-ops/resource-quotas": {
             "get": {
-                "description": "Get UDS Packages",
+                "description": "Get ResourceQuotas",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "configs"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
@@ -1201,58 +1691,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/api/v1/resources/configs/uds-packages/{uid}": {
-            "get": {
-                "description": "Get UDS Package by UID",
-                "consumes": [
-                    "text/html"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "configs"
-                ],
-                "parameters": [
+                    },
                     {
                         "type": "string",
-                        "description": "Get uds package by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1264,47 +1774,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/custom-resource-defintions": {
+        "/api/v1/resources/cluster-ops/resource-quotas/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get Custom Resource Definitions",
+                "description": "Get ResourceQuota by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "resources"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1316,30 +1852,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/custom-resource-defintions/{uid}": {
+        "/api/v1/resources/cluster-ops/resource-quotas/{uid}": {
             "get": {
-                "description": "Get Custom Resource Definition by UID",
+                "description": "Get ResourceQuota by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "resources"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get CRD by uid",
+                        "description": "Get resource-quota by uid",
                         "name": "uid",
                         "in": "path"
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -1362,7 +1922,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1372,20 +1932,52 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
+            },
+            "delete": {
+                "description": "Delete ResourceQuota by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster ops"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ResourceQuota uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
             }
         },
-        "/api/v1/resources/events": {
+        "/api/v1/resources/cluster-ops/runtime-classes": {
             "get": {
-                "description": "Get Events",
+                "description": "Get RuntimeClasses",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "resources"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
@@ -1414,58 +2006,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/api/v1/resources/events/{uid}": {
-            "get": {
-                "description": "Get Event by UID",
-                "consumes": [
-                    "text/html"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "resources"
-                ],
-                "parameters": [
+                    },
                     {
                         "type": "string",
-                        "description": "Get event by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1477,47 +2089,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/namespaces": {
+        "/api/v1/resources/cluster-ops/runtime-classes/name/{name}": {
             "get": {
-                "description": "Get Namespaces",
+                "description": "Get RuntimeClass by name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "resources"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1529,25 +2160,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/namespaces/{uid}": {
+        "/api/v1/resources/cluster-ops/runtime-classes/{uid}": {
             "get": {
-                "description": "Get Namespace by UID",
+                "description": "Get RuntimeClass by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "resources"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get namespace by uid",
+                        "description": "Get runtime-class by uid",
                         "name": "uid",
                         "in": "path"
                     },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
                         "description": "Send the data in dense format",
@@ -1568,7 +2230,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1578,50 +2240,27 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/networks/endpoints": {
-            "get": {
-                "description": "Get Endpoints",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete RuntimeClass by UID",
                 "produces": [
-                    "text/event-stream",
                     "application/json"
                 ],
                 "tags": [
-                    "networks"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
+                        "type": "string",
+                        "description": "RuntimeClass uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -1632,24 +2271,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/networks/endpoints/{uid}": {
+        "/api/v1/resources/cluster-ops/validatingwebhooks": {
             "get": {
-                "description": "Get Endpoint by UID",
+                "description": "Get ValidatingWebhooks",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "networks"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Get endpoint by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
                     },
                     {
                         "type": "boolean",
@@ -1671,7 +2314,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1683,47 +2397,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/networks/networkpolicies": {
+        "/api/v1/resources/cluster-ops/validatingwebhooks/name/{name}": {
             "get": {
-                "description": "Get NetworkPolicies",
+                "description": "Get ValidatingWebhook by name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "networks"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1735,25 +2468,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/networks/networkpolicies/{uid}": {
+        "/api/v1/resources/cluster-ops/validatingwebhooks/{uid}": {
             "get": {
-                "description": "Get NetworkPolicy by UID",
+                "description": "Get ValidatingWebhook by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "networks"
+                    "cluster ops"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get networkpolicy by uid",
+                        "description": "Get validatingwebhook by uid",
                         "name": "uid",
                         "in": "path"
                     },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
                         "description": "Send the data in dense format",
@@ -1774,7 +2538,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1784,20 +2548,52 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
+            },
+            "delete": {
+                "description": "Delete ValidatingWebhook by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster ops"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ValidatingWebhook uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
             }
         },
-        "/api/v1/resources/networks/services": {
+        "/api/v1/resources/configs/configmaps": {
             "get": {
-                "description": "Get Services",
+                "description": "Get ConfigMaps",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "networks"
+                    "configs"
                 ],
                 "parameters": [
                     {
@@ -1826,7 +2622,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1838,46 +2705,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/networks/services/{uid}": {
+        "/api/v1/resources/configs/configmaps/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get Service by UID",
+                "description": "Get ConfigMap by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "networks"
+                    "configs"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get service by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1889,47 +2783,5766 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/networks/virtualservices": {
+        "/api/v1/resources/configs/configmaps/{uid}": {
             "get": {
-                "description": "Get VirtualServices",
+                "description": "Get ConfigMap by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "networks"
+                    "configs"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get configmap by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete ConfigMap by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ConfigMap uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/secrets": {
+            "get": {
+                "description": "Get Secrets",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/secrets/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get Secret by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/secrets/{uid}": {
+            "get": {
+                "description": "Get Secret by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get secret by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Secret by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Secret uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/uds-exemptions": {
+            "get": {
+                "description": "Get UDS Exemptions",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/uds-exemptions/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get UDS Exemption by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/uds-exemptions/{uid}": {
+            "get": {
+                "description": "Get UDS Exemption by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get uds exemption by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete UDS Exemption by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UDS Exemption uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/uds-packages": {
+            "get": {
+                "description": "Get UDS Packages",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/uds-packages/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get UDS Package by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/configs/uds-packages/{uid}": {
+            "get": {
+                "description": "Get UDS Package by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get uds package by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete UDS Package by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "configs"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UDS Package uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/custom-resource-definitions/name/{name}": {
+            "get": {
+                "description": "Get Custom Resource Definition by name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/custom-resource-defintions": {
+            "get": {
+                "description": "Get Custom Resource Definitions",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/custom-resource-defintions/{uid}": {
+            "get": {
+                "description": "Get Custom Resource Definition by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get CRD by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/custom/{group}/{version}/{resource}": {
+            "get": {
+                "description": "Get Custom Resources by group, version and resource",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API group of the custom resource",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version of the custom resource",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Plural resource name of the custom resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/custom/{group}/{version}/{resource}/name/{name}": {
+            "get": {
+                "description": "Get Custom Resource by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API group of the custom resource",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version of the custom resource",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Plural resource name of the custom resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/custom/{group}/{version}/{resource}/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get Custom Resource by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API group of the custom resource",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version of the custom resource",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Plural resource name of the custom resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource, omitted for cluster-scoped resources",
+                        "name": "namespace",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/custom/{group}/{version}/{resource}/{uid}": {
+            "get": {
+                "description": "Get Custom Resource by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API group of the custom resource",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version of the custom resource",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Plural resource name of the custom resource",
+                        "name": "resource",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Get custom resource by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/events": {
+            "get": {
+                "description": "Get Events",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/events/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get Event by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/events/{uid}": {
+            "get": {
+                "description": "Get Event by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get event by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/namespaces": {
+            "get": {
+                "description": "Get Namespaces",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/namespaces/name/{name}": {
+            "get": {
+                "description": "Get Namespace by name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/namespaces/{uid}": {
+            "get": {
+                "description": "Get Namespace by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get namespace by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/endpoints": {
+            "get": {
+                "description": "Get Endpoints",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/endpoints/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get Endpoint by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/endpoints/{uid}": {
+            "get": {
+                "description": "Get Endpoint by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get endpoint by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Endpoint by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Endpoint uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/networkpolicies": {
+            "get": {
+                "description": "Get NetworkPolicies",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/networkpolicies/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get NetworkPolicy by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/networkpolicies/{uid}": {
+            "get": {
+                "description": "Get NetworkPolicy by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get networkpolicy by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete NetworkPolicy by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "NetworkPolicy uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/services": {
+            "get": {
+                "description": "Get Services",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/services/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get Service by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/services/{uid}": {
+            "get": {
+                "description": "Get Service by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get service by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Service by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/virtualservices": {
+            "get": {
+                "description": "Get VirtualServices",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/virtualservices/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get VirtualService by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/networks/virtualservices/{uid}": {
+            "get": {
+                "description": "Get VirtualService by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get virtualservice by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete VirtualService by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "networks"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "VirtualService uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/nodes": {
+            "get": {
+                "description": "Get Nodes",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/nodes/metrics": {
+            "get": {
+                "description": "Get NodeMetrics",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/nodes/name/{name}": {
+            "get": {
+                "description": "Get Node by name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/nodes/{uid}": {
+            "get": {
+                "description": "Get Node by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get node by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/nodes/{uid}/metrics": {
+            "get": {
+                "description": "Get the usage history of a Node, CPU in millicores and memory in bytes",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json"
+                ],
+                "tags": [
+                    "resources"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/persistentvolumeclaims": {
+            "get": {
+                "description": "Get PersistentVolumeClaims",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/persistentvolumeclaims/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get PersistentVolumeClaim by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/persistentvolumeclaims/{uid}": {
+            "get": {
+                "description": "Get PersistentVolumeClaim by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get persistentvolumeclaim by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete PersistentVolumeClaim by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PersistentVolumeClaim uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/persistentvolumes": {
+            "get": {
+                "description": "Get PersistentVolumes",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/persistentvolumes/name/{name}": {
+            "get": {
+                "description": "Get PersistentVolume by name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/persistentvolumes/{uid}": {
+            "get": {
+                "description": "Get PersistentVolume by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get persistentvolume by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete PersistentVolume by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PersistentVolume uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/storageclasses": {
+            "get": {
+                "description": "Get StorageClasses",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/storageclasses/name/{name}": {
+            "get": {
+                "description": "Get StorageClass by name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/storage/storageclasses/{uid}": {
+            "get": {
+                "description": "Get StorageClass by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get storageclass by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete StorageClass by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "storage"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StorageClass uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/cronjobs": {
+            "get": {
+                "description": "Get CronJobs",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/cronjobs/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get CronJob by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/cronjobs/{uid}": {
+            "get": {
+                "description": "Get CronJob by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get cronjob by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete CronJob by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CronJob uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/cronjobs/{uid}/resume": {
+            "post": {
+                "description": "Resume CronJob so jobs are scheduled again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CronJob uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the resume as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/cronjobs/{uid}/suspend": {
+            "post": {
+                "description": "Suspend CronJob so no new jobs are scheduled",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CronJob uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the suspend as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/daemonsets": {
+            "get": {
+                "description": "Get Daemonsets",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/daemonsets/ns/{namespace}/name/{name}": {
+            "get": {
+                "description": "Get Daemonset by namespace and name",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/daemonsets/{uid}": {
+            "get": {
+                "description": "Get Daemonset by UID",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get daemonset by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Daemonset by UID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Daemonset uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/daemonsets/{uid}/logs": {
+            "get": {
+                "description": "Stream logs for all Pods of a DaemonSet, prefixed by pod/container",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "DaemonSet uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Container to stream logs from, defaults to the pod's default container",
+                        "name": "container",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only return logs newer than a relative duration like 5s, 2m, or 3h",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of lines from the end of the logs to show",
+                        "name": "tailLines",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return logs from the previous terminated container",
+                        "name": "previous",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include timestamps on each line",
+                        "name": "timestamps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow the log stream, defaults to true",
+                        "name": "follow",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/daemonsets/{uid}/restart": {
+            "post": {
+                "description": "Restart Daemonset by patching its pod template, equivalent to kubectl rollout restart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Daemonset uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Run the restart as a server-side dry run",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/deployments": {
+            "get": {
+                "description": "Get Deployments",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream",
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial match)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1941,46 +8554,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/networks/virtualservices/{uid}": {
+        "/api/v1/resources/workloads/deployments/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get VirtualService by UID",
+                "description": "Get Deployment by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "networks"
+                    "workloads"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get virtualservice by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -1992,24 +8632,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/nodes": {
+        "/api/v1/resources/workloads/deployments/{uid}": {
             "get": {
-                "description": "Get Nodes",
+                "description": "Get Deployment by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "resources"
+                    "workloads"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get deployment by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -2032,7 +8702,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2042,49 +8712,27 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/nodes/{uid}": {
-            "get": {
-                "description": "Get Node by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete Deployment by UID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "resources"
+                    "workloads"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get node by uid",
+                        "description": "Deployment uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -2095,48 +8743,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/storage/persistentvolumeclaims": {
+        "/api/v1/resources/workloads/deployments/{uid}/logs": {
             "get": {
-                "description": "Get PersistentVolumeClaims",
+                "description": "Stream logs for all Pods of a Deployment, prefixed by pod/container",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "text/event-stream",
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "storage"
+                    "workloads"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Deployment uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "type": "string",
+                        "description": "Container to stream logs from, defaults to the pod's default container",
+                        "name": "container",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Only return logs newer than a relative duration like 5s, 2m, or 3h",
+                        "name": "since",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "type": "integer",
+                        "description": "Number of lines from the end of the logs to show",
+                        "name": "tailLines",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "type": "boolean",
+                        "description": "Return logs from the previous terminated container",
+                        "name": "previous",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include timestamps on each line",
+                        "name": "timestamps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow the log stream, defaults to true",
+                        "name": "follow",
                         "in": "query"
                     }
                 ],
@@ -2147,47 +8807,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/storage/persistentvolumeclaims/{uid}": {
-            "get": {
-                "description": "Get PersistentVolumeClaim by UID",
-                "consumes": [
-                    "text/html"
-                ],
+        "/api/v1/resources/workloads/deployments/{uid}/restart": {
+            "post": {
+                "description": "Restart Deployment by patching its pod template, equivalent to kubectl rollout restart",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "storage"
+                    "workloads"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get persistentvolumeclaim by uid",
+                        "description": "Deployment uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Run the restart as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/deployments/{uid}/scale": {
+            "post": {
+                "description": "Scale Deployment to the requested number of replicas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
+                        "description": "Deployment uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Desired number of replicas",
+                        "name": "replicas",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "type": "boolean",
+                        "description": "Run the scale as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -2198,18 +8876,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/storage/persistentvolumes": {
+        "/api/v1/resources/workloads/jobs": {
             "get": {
-                "description": "Get PersistentVolumes",
+                "description": "Get Jobs",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "storage"
+                    "workloads"
                 ],
                 "parameters": [
                     {
@@ -2238,7 +8919,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2250,46 +9002,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/storage/persistentvolumes/{uid}": {
+        "/api/v1/resources/workloads/jobs/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get PersistentVolume by UID",
+                "description": "Get Job by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "storage"
+                    "workloads"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get persistentvolume by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2301,24 +9080,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/storage/storageclasses": {
+        "/api/v1/resources/workloads/jobs/{uid}": {
             "get": {
-                "description": "Get StorageClasses",
+                "description": "Get Job by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "storage"
+                    "workloads"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get job by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -2341,7 +9150,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2351,49 +9160,27 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/storage/storageclasses/{uid}": {
-            "get": {
-                "description": "Get StorageClass by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete Job by UID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "storage"
+                    "workloads"
                 ],
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get storageclass by uid",
+                        "description": "Job uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -2404,15 +9191,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/cronjobs": {
+        "/api/v1/resources/workloads/podmetrics": {
             "get": {
-                "description": "Get CronJobs",
+                "description": "Get PodMetrics",
+                "consumes": [
+                    "text/html"
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/pods": {
+            "get": {
+                "description": "Get Pods",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "workloads"
@@ -2444,7 +9253,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2456,14 +9336,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/cronjobs/{uid}": {
+        "/api/v1/resources/workloads/pods/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get CronJob by UID",
+                "description": "Get Pod by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "workloads"
@@ -2471,31 +9355,54 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get cronjob by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2507,24 +9414,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/daemonsets": {
+        "/api/v1/resources/workloads/pods/{uid}": {
             "get": {
-                "description": "Get Daemonsets",
+                "description": "Get Pod by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "workloads"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get pod by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -2547,7 +9484,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2557,14 +9494,9 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/workloads/daemonsets/{uid}": {
-            "get": {
-                "description": "Get Daemonset by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete Pod by UID",
                 "produces": [
                     "application/json"
                 ],
@@ -2574,84 +9506,126 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get daemonset by uid",
+                        "description": "Pod uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/pods/{uid}/exec": {
+            "get": {
+                "description": "Exec into a Pod over a WebSocket. Messages are JSON objects with a type of stdin, resize, stdout, stderr, error or exit. Their data is base64 encoded so binary output is sent unchanged.",
+                "consumes": [
+                    "text/html"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pod uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Container to exec into, defaults to the pod's default container",
+                        "name": "container",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Command to run, repeat for each argument. Defaults to /bin/sh",
+                        "name": "command",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "type": "boolean",
+                        "description": "Allocate a TTY, defaults to true. Stderr is merged into stdout when a TTY is allocated",
+                        "name": "tty",
                         "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "101": {
+                        "description": "Switching Protocols"
                     }
                 }
             }
         },
-        "/api/v1/resources/workloads/deployments": {
+        "/api/v1/resources/workloads/pods/{uid}/logs": {
             "get": {
-                "description": "Get Deployments",
+                "description": "Stream Pod logs",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "text/event-stream",
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
                     "workloads"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Pod uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "type": "string",
+                        "description": "Container to stream logs from, defaults to the pod's default container",
+                        "name": "container",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Only return logs newer than a relative duration like 5s, 2m, or 3h",
+                        "name": "since",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "type": "integer",
+                        "description": "Number of lines from the end of the logs to show",
+                        "name": "tailLines",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "type": "boolean",
+                        "description": "Return logs from the previous terminated container",
+                        "name": "previous",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include timestamps on each line",
+                        "name": "timestamps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow the log stream, defaults to true",
+                        "name": "follow",
                         "in": "query"
                     }
                 ],
@@ -2662,13 +9636,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/deployments/{uid}": {
+        "/api/v1/resources/workloads/pods/{uid}/metrics": {
             "get": {
-                "description": "Get Deployment by UID",
+                "description": "Get the usage history of a Pod, CPU in millicores and memory in bytes",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "text/event-stream",
                     "application/json"
                 ],
                 "tags": [
@@ -2677,32 +9652,15 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get deployment by uid",
+                        "description": "Pod uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
+                        "name": "once",
                         "in": "query"
                     }
                 ],
@@ -2713,15 +9671,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/jobs": {
+        "/api/v1/resources/workloads/statefulsets": {
             "get": {
-                "description": "Get Jobs",
+                "description": "Get Statefulsets",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
                     "text/event-stream",
-                    "application/json"
+                    "application/json",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "workloads"
@@ -2753,7 +9714,78 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by label selector. Format: app=foo,tier!=db",
+                        "name": "labelSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1",
+                        "name": "fieldSelector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of resources per page, the response includes the total count and a continue token",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue token from the previous page",
+                        "name": "continue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "delta"
+                        ],
+                        "type": "string",
+                        "description": "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2765,14 +9797,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/jobs/{uid}": {
+        "/api/v1/resources/workloads/statefulsets/ns/{namespace}/name/{name}": {
             "get": {
-                "description": "Get Job by UID",
+                "description": "Get Statefulset by namespace and name",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream",
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "workloads"
@@ -2780,31 +9816,54 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get job by uid",
-                        "name": "uid",
-                        "in": "path"
+                        "description": "Namespace of the resource",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed",
+                        "name": "stream",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "table"
+                        ],
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Send the data in dense format",
+                        "name": "dense",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2816,43 +9875,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/podmetrics": {
-            "get": {
-                "description": "Get PodMetrics",
-                "consumes": [
-                    "text/html"
-                ],
-                "produces": [
-                    "text/event-stream"
-                ],
-                "tags": [
-                    "workloads"
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/api/v1/resources/workloads/pods": {
+        "/api/v1/resources/workloads/statefulsets/{uid}": {
             "get": {
-                "description": "Get Pods",
+                "description": "Get Statefulset by UID",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
+                    "application/json",
                     "text/event-stream",
-                    "application/json"
+                    "application/yaml",
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
                     "workloads"
                 ],
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Get statefulset by uid",
+                        "name": "uid",
+                        "in": "path"
+                    },
                     {
                         "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
+                        "description": "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "table"
+                        ],
+                        "type": "string",
+                        "description": "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field",
+                        "name": "output",
                         "in": "query"
                     },
                     {
@@ -2875,7 +9945,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
+                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\\.kubernetes\\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status",
                         "name": "fields",
                         "in": "query"
                     }
@@ -2885,14 +9955,9 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/v1/resources/workloads/pods/{uid}": {
-            "get": {
-                "description": "Get Pod by UID",
-                "consumes": [
-                    "text/html"
-                ],
+            },
+            "delete": {
+                "description": "Delete Statefulset by UID",
                 "produces": [
                     "application/json"
                 ],
@@ -2902,32 +9967,15 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get pod by uid",
+                        "description": "Statefulset uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "description": "Run the delete as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -2938,48 +9986,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/statefulsets": {
+        "/api/v1/resources/workloads/statefulsets/{uid}/logs": {
             "get": {
-                "description": "Get Statefulsets",
+                "description": "Stream logs for all Pods of a StatefulSet, prefixed by pod/container",
                 "consumes": [
                     "text/html"
                 ],
                 "produces": [
-                    "text/event-stream",
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
                     "workloads"
                 ],
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Send the data once and close the connection. By default this is set to` + "`" + `false` + "`" + ` and will return a text/event-stream. If set to ` + "`" + `true` + "`" + ` the response content type is application/json.",
-                        "name": "once",
-                        "in": "query"
+                        "type": "string",
+                        "description": "StatefulSet uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "type": "string",
+                        "description": "Container to stream logs from, defaults to the pod's default container",
+                        "name": "container",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
+                        "description": "Only return logs newer than a relative duration like 5s, 2m, or 3h",
+                        "name": "since",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
+                        "type": "integer",
+                        "description": "Number of lines from the end of the logs to show",
+                        "name": "tailLines",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "type": "boolean",
+                        "description": "Return logs from the previous terminated container",
+                        "name": "previous",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include timestamps on each line",
+                        "name": "timestamps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow the log stream, defaults to true",
+                        "name": "follow",
                         "in": "query"
                     }
                 ],
@@ -2990,12 +10050,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/resources/workloads/statefulsets/{uid}": {
-            "get": {
-                "description": "Get Statefulset by UID",
-                "consumes": [
-                    "text/html"
-                ],
+        "/api/v1/resources/workloads/statefulsets/{uid}/restart": {
+            "post": {
+                "description": "Restart Statefulset by patching its pod template, equivalent to kubectl rollout restart",
                 "produces": [
                     "application/json"
                 ],
@@ -3005,32 +10062,53 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Get statefulset by uid",
+                        "description": "Statefulset uid",
                         "name": "uid",
-                        "in": "path"
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Send the data in dense format",
-                        "name": "dense",
+                        "description": "Run the restart as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/v1/resources/workloads/statefulsets/{uid}/scale": {
+            "post": {
+                "description": "Scale Statefulset to the requested number of replicas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workloads"
+                ],
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by namespace",
-                        "name": "namespace",
-                        "in": "query"
+                        "description": "Statefulset uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by name (partial match)",
-                        "name": "name",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Desired number of replicas",
+                        "name": "replicas",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status",
-                        "name": "fields",
+                        "type": "boolean",
+                        "description": "Run the scale as a server-side dry run",
+                        "name": "dryRun",
                         "in": "query"
                     }
                 ],
@@ -3113,6 +10191,33 @@ const docTemplate = `{
                 }
             }
         }
+    },
+    "definitions": {
+        "client.KubeContext": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "session.ContextsResponse": {
+            "type": "object",
+            "properties": {
+                "contexts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/client.KubeContext"
+                    }
+                },
+                "current": {
+                    "type": "string"
+                }
+            }
+        }
     }
 }`
 
@@ -11,22 +11,34 @@ import (
 
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/local"
 	_ "github.com/defenseunicorns/uds-runtime/src/pkg/api/docs" //nolint:staticcheck
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/monitor"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/rest"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/terminal"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/k8s/client"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/k8s/session"
 )
 
 // @Description Get Nodes
 // @Tags resources
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/nodes [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getNodes(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Nodes)
 }
@@ -34,29 +46,88 @@ func getNodes(cache *resources.Cache) func(w http.ResponseWriter, r *http.Reques
 // @Description Get Node by UID
 // @Tags resources
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/nodes/{uid} [get]
 // @Param uid path string false "Get node by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getNode(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Nodes)
 }
 
-// @Description Get Events
+// @Description Get Node by name
+// @Tags resources
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/nodes/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getNodeByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Nodes)
+}
+
+// @Description Get NodeMetrics
+// @Tags resources
+// @Accept  html
+// @Produce text/event-stream
+// @Success 200
+// @Router /api/v1/resources/nodes/metrics [get]
+func getNodeMetrics(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Node metrics are not collected when RBAC does not allow listing nodes
+		if cache.Nodes.Forbidden {
+			http.Error(w, "Not allowed to list Node resources", http.StatusForbidden)
+			return
+		}
+		changes, unsubscribe := cache.NodeMetricsChanges.Subscribe()
+		defer unsubscribe()
+		rest.Handler(w, r, cache.NodeMetrics.GetAll, changes, nil, nil)
+	}
+}
+
+// @Description Get the usage history of a Node, CPU in millicores and memory in bytes
 // @Tags resources
 // @Accept  html
 // @Produce text/event-stream,json
 // @Success 200
+// @Router /api/v1/resources/nodes/{uid}/metrics [get]
+// @Param uid path string true "Node uid"
+// @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
+func getNodeUsage(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindUsage(cache.Nodes, cache.NodeMetrics.GetResourceUsage, &cache.NodeMetricsChanges)
+}
+
+// @Description Get Events
+// @Tags resources
+// @Accept  html
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
+// @Success 200
 // @Router /api/v1/resources/events [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getEvents(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Events)
 }
@@ -64,29 +135,58 @@ func getEvents(cache *resources.Cache) func(w http.ResponseWriter, r *http.Reque
 // @Description Get Event by UID
 // @Tags resources
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/events/{uid} [get]
 // @Param uid path string false "Get event by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getEvent(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Events)
 }
 
+// @Description Get Event by namespace and name
+// @Tags resources
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/events/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getEventByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Events)
+}
+
 // @Description Get Namespaces
 // @Tags resources
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/namespaces [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getNamespaces(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Namespaces)
 }
@@ -94,29 +194,57 @@ func getNamespaces(cache *resources.Cache) func(w http.ResponseWriter, r *http.R
 // @Description Get Namespace by UID
 // @Tags resources
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/namespaces/{uid} [get]
 // @Param uid path string false "Get namespace by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getNamespace(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Namespaces)
 }
 
+// @Description Get Namespace by name
+// @Tags resources
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/namespaces/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getNamespaceByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Namespaces)
+}
+
 // @Description Get Pods
 // @Tags workloads
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/pods [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPods(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Pods)
 }
@@ -124,29 +252,111 @@ func getPods(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request
 // @Description Get Pod by UID
 // @Tags workloads
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/pods/{uid} [get]
 // @Param uid path string false "Get pod by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPod(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Pods)
 }
 
-// @Description Get Deployments
+// @Description Get Pod by namespace and name
+// @Tags workloads
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/workloads/pods/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getPodByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Pods)
+}
+
+// @Description Get the usage history of a Pod, CPU in millicores and memory in bytes
 // @Tags workloads
 // @Accept  html
 // @Produce text/event-stream,json
 // @Success 200
+// @Router /api/v1/resources/workloads/pods/{uid}/metrics [get]
+// @Param uid path string true "Pod uid"
+// @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
+func getPodUsage(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindUsage(cache.Pods, cache.PodMetrics.GetResourceUsage, &cache.MetricsChanges)
+}
+
+// @Description Delete Pod by UID
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/pods/{uid} [delete]
+// @Param uid path string true "Pod uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deletePod(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Pods, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Stream Pod logs
+// @Tags workloads
+// @Accept  html
+// @Produce text/event-stream
+// @Success 200
+// @Router /api/v1/resources/workloads/pods/{uid}/logs [get]
+// @Param uid path string true "Pod uid"
+// @Param container query string false "Container to stream logs from, defaults to the pod's default container"
+// @Param since query string false "Only return logs newer than a relative duration like 5s, 2m, or 3h"
+// @Param tailLines query int false "Number of lines from the end of the logs to show"
+// @Param previous query bool false "Return logs from the previous terminated container"
+// @Param timestamps query bool false "Include timestamps on each line"
+// @Param follow query bool false "Follow the log stream, defaults to true"
+func getPodLogs(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return monitor.BindPodLogsHandler(cache.Pods, clients.Clientset)
+}
+
+// @Description Exec into a Pod over a WebSocket. Messages are JSON objects with a type of stdin, resize, stdout, stderr, error or exit. Their data is base64 encoded so binary output is sent unchanged.
+// @Tags workloads
+// @Accept  html
+// @Success 101
+// @Router /api/v1/resources/workloads/pods/{uid}/exec [get]
+// @Param uid path string true "Pod uid"
+// @Param container query string false "Container to exec into, defaults to the pod's default container"
+// @Param command query []string false "Command to run, repeat for each argument. Defaults to /bin/sh" collectionFormat(multi)
+// @Param tty query bool false "Allocate a TTY, defaults to true. Stderr is merged into stdout when a TTY is allocated"
+func execPod(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return terminal.BindExecHandler(cache.Pods, clients.Clientset, clients.Config)
+}
+
+// @Description Get Deployments
+// @Tags workloads
+// @Accept  html
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
+// @Success 200
 // @Router /api/v1/resources/workloads/deployments [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getDeployments(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Deployments)
 }
@@ -154,29 +364,109 @@ func getDeployments(cache *resources.Cache) func(w http.ResponseWriter, r *http.
 // @Description Get Deployment by UID
 // @Tags workloads
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/deployments/{uid} [get]
 // @Param uid path string false "Get deployment by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getDeployment(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Deployments)
 }
 
+// @Description Get Deployment by namespace and name
+// @Tags workloads
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/workloads/deployments/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getDeploymentByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Deployments)
+}
+
+// @Description Delete Deployment by UID
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/deployments/{uid} [delete]
+// @Param uid path string true "Deployment uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteDeployment(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Deployments, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Restart Deployment by patching its pod template, equivalent to kubectl rollout restart
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/deployments/{uid}/restart [post]
+// @Param uid path string true "Deployment uid"
+// @Param dryRun query bool false "Run the restart as a server-side dry run"
+func restartDeployment(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindRestart(cache.Deployments, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Scale Deployment to the requested number of replicas
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/deployments/{uid}/scale [post]
+// @Param uid path string true "Deployment uid"
+// @Param replicas query int true "Desired number of replicas"
+// @Param dryRun query bool false "Run the scale as a server-side dry run"
+func scaleDeployment(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindScale(cache.Deployments, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Stream logs for all Pods of a Deployment, prefixed by pod/container
+// @Tags workloads
+// @Accept  html
+// @Produce text/event-stream
+// @Success 200
+// @Router /api/v1/resources/workloads/deployments/{uid}/logs [get]
+// @Param uid path string true "Deployment uid"
+// @Param container query string false "Container to stream logs from, defaults to the pod's default container"
+// @Param since query string false "Only return logs newer than a relative duration like 5s, 2m, or 3h"
+// @Param tailLines query int false "Number of lines from the end of the logs to show"
+// @Param previous query bool false "Return logs from the previous terminated container"
+// @Param timestamps query bool false "Include timestamps on each line"
+// @Param follow query bool false "Follow the log stream, defaults to true"
+func getDeploymentLogs(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return monitor.BindWorkloadLogsHandler(cache.Deployments, clients.Clientset)
+}
+
 // @Description Get Daemonsets
 // @Tags workloads
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/daemonsets [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getDaemonsets(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Daemonsets)
 }
@@ -184,29 +474,97 @@ func getDaemonsets(cache *resources.Cache) func(w http.ResponseWriter, r *http.R
 // @Description Get Daemonset by UID
 // @Tags workloads
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/daemonsets/{uid} [get]
 // @Param uid path string false "Get daemonset by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getDaemonset(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Daemonsets)
 }
 
+// @Description Get Daemonset by namespace and name
+// @Tags workloads
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/workloads/daemonsets/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getDaemonsetByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Daemonsets)
+}
+
+// @Description Delete Daemonset by UID
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/daemonsets/{uid} [delete]
+// @Param uid path string true "Daemonset uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteDaemonset(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Daemonsets, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Restart Daemonset by patching its pod template, equivalent to kubectl rollout restart
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/daemonsets/{uid}/restart [post]
+// @Param uid path string true "Daemonset uid"
+// @Param dryRun query bool false "Run the restart as a server-side dry run"
+func restartDaemonset(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindRestart(cache.Daemonsets, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Stream logs for all Pods of a DaemonSet, prefixed by pod/container
+// @Tags workloads
+// @Accept  html
+// @Produce text/event-stream
+// @Success 200
+// @Router /api/v1/resources/workloads/daemonsets/{uid}/logs [get]
+// @Param uid path string true "DaemonSet uid"
+// @Param container query string false "Container to stream logs from, defaults to the pod's default container"
+// @Param since query string false "Only return logs newer than a relative duration like 5s, 2m, or 3h"
+// @Param tailLines query int false "Number of lines from the end of the logs to show"
+// @Param previous query bool false "Return logs from the previous terminated container"
+// @Param timestamps query bool false "Include timestamps on each line"
+// @Param follow query bool false "Follow the log stream, defaults to true"
+func getDaemonsetLogs(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return monitor.BindWorkloadLogsHandler(cache.Daemonsets, clients.Clientset)
+}
+
 // @Description Get Statefulsets
 // @Tags workloads
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/statefulsets [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getStatefulsets(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Statefulsets)
 }
@@ -214,29 +572,109 @@ func getStatefulsets(cache *resources.Cache) func(w http.ResponseWriter, r *http
 // @Description Get Statefulset by UID
 // @Tags workloads
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/statefulsets/{uid} [get]
 // @Param uid path string false "Get statefulset by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getStatefulset(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Statefulsets)
 }
 
+// @Description Get Statefulset by namespace and name
+// @Tags workloads
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/workloads/statefulsets/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getStatefulsetByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Statefulsets)
+}
+
+// @Description Delete Statefulset by UID
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/statefulsets/{uid} [delete]
+// @Param uid path string true "Statefulset uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteStatefulset(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Statefulsets, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Restart Statefulset by patching its pod template, equivalent to kubectl rollout restart
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/statefulsets/{uid}/restart [post]
+// @Param uid path string true "Statefulset uid"
+// @Param dryRun query bool false "Run the restart as a server-side dry run"
+func restartStatefulset(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindRestart(cache.Statefulsets, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Scale Statefulset to the requested number of replicas
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/statefulsets/{uid}/scale [post]
+// @Param uid path string true "Statefulset uid"
+// @Param replicas query int true "Desired number of replicas"
+// @Param dryRun query bool false "Run the scale as a server-side dry run"
+func scaleStatefulset(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindScale(cache.Statefulsets, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Stream logs for all Pods of a StatefulSet, prefixed by pod/container
+// @Tags workloads
+// @Accept  html
+// @Produce text/event-stream
+// @Success 200
+// @Router /api/v1/resources/workloads/statefulsets/{uid}/logs [get]
+// @Param uid path string true "StatefulSet uid"
+// @Param container query string false "Container to stream logs from, defaults to the pod's default container"
+// @Param since query string false "Only return logs newer than a relative duration like 5s, 2m, or 3h"
+// @Param tailLines query int false "Number of lines from the end of the logs to show"
+// @Param previous query bool false "Return logs from the previous terminated container"
+// @Param timestamps query bool false "Include timestamps on each line"
+// @Param follow query bool false "Follow the log stream, defaults to true"
+func getStatefulsetLogs(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return monitor.BindWorkloadLogsHandler(cache.Statefulsets, clients.Clientset)
+}
+
 // @Description Get Jobs
 // @Tags workloads
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/jobs [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getJobs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Jobs)
 }
@@ -244,29 +682,69 @@ func getJobs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request
 // @Description Get Job by UID
 // @Tags workloads
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/jobs/{uid} [get]
 // @Param uid path string false "Get job by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getJob(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Jobs)
 }
 
+// @Description Get Job by namespace and name
+// @Tags workloads
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/workloads/jobs/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getJobByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Jobs)
+}
+
+// @Description Delete Job by UID
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/jobs/{uid} [delete]
+// @Param uid path string true "Job uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteJob(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Jobs, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get CronJobs
 // @Tags workloads
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/cronjobs [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getCronJobs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.CronJobs)
 }
@@ -274,18 +752,71 @@ func getCronJobs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Req
 // @Description Get CronJob by UID
 // @Tags workloads
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/workloads/cronjobs/{uid} [get]
 // @Param uid path string false "Get cronjob by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getCronJob(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.CronJobs)
 }
 
+// @Description Get CronJob by namespace and name
+// @Tags workloads
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/workloads/cronjobs/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getCronJobByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.CronJobs)
+}
+
+// @Description Delete CronJob by UID
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/cronjobs/{uid} [delete]
+// @Param uid path string true "CronJob uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteCronJob(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.CronJobs, clients.DynamicClient, clients.RESTMapper)
+}
+
+// @Description Suspend CronJob so no new jobs are scheduled
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/cronjobs/{uid}/suspend [post]
+// @Param uid path string true "CronJob uid"
+// @Param dryRun query bool false "Run the suspend as a server-side dry run"
+func suspendCronJob(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindSuspend(cache.CronJobs, clients.DynamicClient, clients.RESTMapper, true)
+}
+
+// @Description Resume CronJob so jobs are scheduled again
+// @Tags workloads
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/workloads/cronjobs/{uid}/resume [post]
+// @Param uid path string true "CronJob uid"
+// @Param dryRun query bool false "Run the resume as a server-side dry run"
+func resumeCronJob(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindSuspend(cache.CronJobs, clients.DynamicClient, clients.RESTMapper, false)
+}
+
 // @Description Get PodMetrics
 // @Tags workloads
 // @Accept  html
@@ -293,20 +824,31 @@ func getCronJob(cache *resources.Cache) func(w http.ResponseWriter, r *http.Requ
 // @Success 200
 // @Router /api/v1/resources/workloads/podmetrics [get]
 func getPodMetrics(w http.ResponseWriter, r *http.Request, cache *resources.Cache) {
-	rest.Handler(w, r, cache.PodMetrics.GetAll, cache.MetricsChanges, nil, nil)
+	changes, unsubscribe := cache.MetricsChanges.Subscribe()
+	defer unsubscribe()
+	rest.Handler(w, r, cache.PodMetrics.GetAll, changes, nil, nil)
 }
 
 // @Description Get UDS Packages
 // @Tags configs
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/uds-packages [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getUDSPackages(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.BindCustomResource(cache.UDSPackages, cache)
 }
@@ -314,29 +856,69 @@ func getUDSPackages(cache *resources.Cache) func(w http.ResponseWriter, r *http.
 // @Description Get UDS Package by UID
 // @Tags configs
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/uds-packages/{uid} [get]
 // @Param uid path string false "Get uds package by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getUDSPackage(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.BindCustomResource(cache.UDSPackages, cache)
 }
 
+// @Description Get UDS Package by namespace and name
+// @Tags configs
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/configs/uds-packages/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getUDSPackageByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindCustomResource(cache.UDSPackages, cache)
+}
+
+// @Description Delete UDS Package by UID
+// @Tags configs
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/configs/uds-packages/{uid} [delete]
+// @Param uid path string true "UDS Package uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteUDSPackage(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.UDSPackages, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get UDS Exemptions
 // @Tags configs
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/uds-exemptions [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getUDSExemptions(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.BindCustomResource(cache.UDSExemptions, cache)
 }
@@ -344,29 +926,69 @@ func getUDSExemptions(cache *resources.Cache) func(w http.ResponseWriter, r *htt
 // @Description Get UDS Exemption by UID
 // @Tags configs
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/uds-exemptions/{uid} [get]
 // @Param uid path string false "Get uds exemption by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getUDSExemption(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.BindCustomResource(cache.UDSExemptions, cache)
 }
 
+// @Description Get UDS Exemption by namespace and name
+// @Tags configs
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/configs/uds-exemptions/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getUDSExemptionByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindCustomResource(cache.UDSExemptions, cache)
+}
+
+// @Description Delete UDS Exemption by UID
+// @Tags configs
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/configs/uds-exemptions/{uid} [delete]
+// @Param uid path string true "UDS Exemption uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteUDSExemption(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.UDSExemptions, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get ConfigMaps
 // @Tags configs
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/configmaps [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getConfigMaps(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Configmaps)
 }
@@ -374,29 +996,69 @@ func getConfigMaps(cache *resources.Cache) func(w http.ResponseWriter, r *http.R
 // @Description Get ConfigMap by UID
 // @Tags configs
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/configmaps/{uid} [get]
 // @Param uid path string false "Get configmap by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getConfigMap(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Configmaps)
 }
 
+// @Description Get ConfigMap by namespace and name
+// @Tags configs
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/configs/configmaps/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getConfigMapByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Configmaps)
+}
+
+// @Description Delete ConfigMap by UID
+// @Tags configs
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/configs/configmaps/{uid} [delete]
+// @Param uid path string true "ConfigMap uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteConfigMap(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Configmaps, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get Secrets
 // @Tags configs
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/secrets [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getSecrets(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Secrets)
 }
@@ -404,29 +1066,69 @@ func getSecrets(cache *resources.Cache) func(w http.ResponseWriter, r *http.Requ
 // @Description Get Secret by UID
 // @Tags configs
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/configs/secrets/{uid} [get]
 // @Param uid path string false "Get secret by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getSecret(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Secrets)
 }
 
+// @Description Get Secret by namespace and name
+// @Tags configs
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/configs/secrets/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getSecretByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Secrets)
+}
+
+// @Description Delete Secret by UID
+// @Tags configs
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/configs/secrets/{uid} [delete]
+// @Param uid path string true "Secret uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteSecret(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Secrets, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get MutatingWebhooks
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/mutatingwebhooks [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getMutatingWebhooks(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.MutatingWebhooks)
 }
@@ -434,29 +1136,68 @@ func getMutatingWebhooks(cache *resources.Cache) func(w http.ResponseWriter, r *
 // @Description Get MutatingWebhook by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/mutatingwebhooks/{uid} [get]
 // @Param uid path string false "Get mutatingwebhook by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getMutatingWebhook(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.MutatingWebhooks)
 }
 
+// @Description Get MutatingWebhook by name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/mutatingwebhooks/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getMutatingWebhookByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.MutatingWebhooks)
+}
+
+// @Description Delete MutatingWebhook by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/mutatingwebhooks/{uid} [delete]
+// @Param uid path string true "MutatingWebhook uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteMutatingWebhook(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.MutatingWebhooks, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get ValidatingWebhooks
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/validatingwebhooks [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getValidatingWebhooks(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.ValidatingWebhooks)
 }
@@ -464,29 +1205,68 @@ func getValidatingWebhooks(cache *resources.Cache) func(w http.ResponseWriter, r
 // @Description Get ValidatingWebhook by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/validatingwebhooks/{uid} [get]
 // @Param uid path string false "Get validatingwebhook by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getValidatingWebhook(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.ValidatingWebhooks)
 }
 
+// @Description Get ValidatingWebhook by name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/validatingwebhooks/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getValidatingWebhookByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.ValidatingWebhooks)
+}
+
+// @Description Delete ValidatingWebhook by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/validatingwebhooks/{uid} [delete]
+// @Param uid path string true "ValidatingWebhook uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteValidatingWebhook(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.ValidatingWebhooks, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get HPAs
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/hpas [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getHPAs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.HPAs)
 }
@@ -494,29 +1274,69 @@ func getHPAs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request
 // @Description Get HPA by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/hpas/{uid} [get]
 // @Param uid path string false "Get hpa by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getHPA(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.HPAs)
 }
 
+// @Description Get HPA by namespace and name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/hpas/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getHPAByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.HPAs)
+}
+
+// @Description Delete HPA by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/hpas/{uid} [delete]
+// @Param uid path string true "HPA uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteHPA(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.HPAs, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get PriorityClasses
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/priority-classes [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPriorityClasses(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PriorityClasses)
 }
@@ -524,29 +1344,68 @@ func getPriorityClasses(cache *resources.Cache) func(w http.ResponseWriter, r *h
 // @Description Get PriorityClass by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/priority-classes/{uid} [get]
 // @Param uid path string false "Get priority-class by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPriorityClass(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PriorityClasses)
 }
 
+// @Description Get PriorityClass by name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/priority-classes/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getPriorityClassByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.PriorityClasses)
+}
+
+// @Description Delete PriorityClass by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/priority-classes/{uid} [delete]
+// @Param uid path string true "PriorityClass uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deletePriorityClass(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.PriorityClasses, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get RuntimeClasses
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/runtime-classes [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getRuntimeClasses(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.RuntimeClasses)
 }
@@ -554,29 +1413,68 @@ func getRuntimeClasses(cache *resources.Cache) func(w http.ResponseWriter, r *ht
 // @Description Get RuntimeClass by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/runtime-classes/{uid} [get]
 // @Param uid path string false "Get runtime-class by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getRuntimeClass(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.RuntimeClasses)
 }
 
+// @Description Get RuntimeClass by name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/runtime-classes/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getRuntimeClassByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.RuntimeClasses)
+}
+
+// @Description Delete RuntimeClass by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/runtime-classes/{uid} [delete]
+// @Param uid path string true "RuntimeClass uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteRuntimeClass(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.RuntimeClasses, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get PodDisruptionBudgets
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/poddisruptionbudgets [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPodDisruptionBudgets(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PodDisruptionBudgets)
 }
@@ -584,29 +1482,69 @@ func getPodDisruptionBudgets(cache *resources.Cache) func(w http.ResponseWriter,
 // @Description Get PodDisruptionBudget by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/poddisruptionbudgets/{uid} [get]
 // @Param uid path string false "Get poddisruptionbudget by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPodDisruptionBudget(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PodDisruptionBudgets)
 }
 
+// @Description Get PodDisruptionBudget by namespace and name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/poddisruptionbudgets/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getPodDisruptionBudgetByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.PodDisruptionBudgets)
+}
+
+// @Description Delete PodDisruptionBudget by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/poddisruptionbudgets/{uid} [delete]
+// @Param uid path string true "PodDisruptionBudget uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deletePodDisruptionBudget(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.PodDisruptionBudgets, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get LimitRanges
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/limit-ranges [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getLimitRanges(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.LimitRanges)
 }
@@ -614,29 +1552,69 @@ func getLimitRanges(cache *resources.Cache) func(w http.ResponseWriter, r *http.
 // @Description Get LimitRange by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/limit-ranges/{uid} [get]
 // @Param uid path string false "Get limit-range by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getLimitRange(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.LimitRanges)
 }
 
+// @Description Get LimitRange by namespace and name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/limit-ranges/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getLimitRangeByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.LimitRanges)
+}
+
+// @Description Delete LimitRange by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/limit-ranges/{uid} [delete]
+// @Param uid path string true "LimitRange uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteLimitRange(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.LimitRanges, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get ResourceQuotas
 // @Tags cluster ops
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/resource-quotas [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getResourceQuotas(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.ResourceQuotas)
 }
@@ -644,29 +1622,69 @@ func getResourceQuotas(cache *resources.Cache) func(w http.ResponseWriter, r *ht
 // @Description Get ResourceQuota by UID
 // @Tags cluster ops
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/cluster-ops/resource-quotas/{uid} [get]
 // @Param uid path string false "Get resource-quota by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getResourceQuota(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.ResourceQuotas)
 }
 
+// @Description Get ResourceQuota by namespace and name
+// @Tags cluster ops
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/resource-quotas/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getResourceQuotaByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.ResourceQuotas)
+}
+
+// @Description Delete ResourceQuota by UID
+// @Tags cluster ops
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/cluster-ops/resource-quotas/{uid} [delete]
+// @Param uid path string true "ResourceQuota uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteResourceQuota(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.ResourceQuotas, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get Services
 // @Tags networks
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/services [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getServices(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Services)
 }
@@ -674,29 +1692,69 @@ func getServices(cache *resources.Cache) func(w http.ResponseWriter, r *http.Req
 // @Description Get Service by UID
 // @Tags networks
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/services/{uid} [get]
 // @Param uid path string false "Get service by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getService(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Services)
 }
 
+// @Description Get Service by namespace and name
+// @Tags networks
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/networks/services/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getServiceByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Services)
+}
+
+// @Description Delete Service by UID
+// @Tags networks
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/networks/services/{uid} [delete]
+// @Param uid path string true "Service uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteService(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Services, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get NetworkPolicies
 // @Tags networks
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/networkpolicies [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getNetworkPolicies(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.NetworkPolicies)
 }
@@ -704,29 +1762,69 @@ func getNetworkPolicies(cache *resources.Cache) func(w http.ResponseWriter, r *h
 // @Description Get NetworkPolicy by UID
 // @Tags networks
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/networkpolicies/{uid} [get]
 // @Param uid path string false "Get networkpolicy by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getNetworkPolicy(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.NetworkPolicies)
 }
 
+// @Description Get NetworkPolicy by namespace and name
+// @Tags networks
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/networks/networkpolicies/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getNetworkPolicyByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.NetworkPolicies)
+}
+
+// @Description Delete NetworkPolicy by UID
+// @Tags networks
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/networks/networkpolicies/{uid} [delete]
+// @Param uid path string true "NetworkPolicy uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteNetworkPolicy(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.NetworkPolicies, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get Endpoints
 // @Tags networks
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/endpoints [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getEndpoints(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Endpoints)
 }
@@ -734,29 +1832,69 @@ func getEndpoints(cache *resources.Cache) func(w http.ResponseWriter, r *http.Re
 // @Description Get Endpoint by UID
 // @Tags networks
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/endpoints/{uid} [get]
 // @Param uid path string false "Get endpoint by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getEndpoint(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.Endpoints)
 }
 
+// @Description Get Endpoint by namespace and name
+// @Tags networks
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/networks/endpoints/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getEndpointByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.Endpoints)
+}
+
+// @Description Delete Endpoint by UID
+// @Tags networks
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/networks/endpoints/{uid} [delete]
+// @Param uid path string true "Endpoint uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteEndpoint(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.Endpoints, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get VirtualServices
 // @Tags networks
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/virtualservices [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getVirtualServices(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.BindCustomResource(cache.VirtualServices, cache)
 }
@@ -764,29 +1902,69 @@ func getVirtualServices(cache *resources.Cache) func(w http.ResponseWriter, r *h
 // @Description Get VirtualService by UID
 // @Tags networks
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/networks/virtualservices/{uid} [get]
 // @Param uid path string false "Get virtualservice by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getVirtualService(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.BindCustomResource(cache.VirtualServices, cache)
 }
 
+// @Description Get VirtualService by namespace and name
+// @Tags networks
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/networks/virtualservices/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getVirtualServiceByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindCustomResource(cache.VirtualServices, cache)
+}
+
+// @Description Delete VirtualService by UID
+// @Tags networks
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/networks/virtualservices/{uid} [delete]
+// @Param uid path string true "VirtualService uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteVirtualService(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.VirtualServices, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get PersistentVolumes
 // @Tags storage
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/storage/persistentvolumes [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPersistentVolumes(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PersistentVolumes)
 }
@@ -794,29 +1972,68 @@ func getPersistentVolumes(cache *resources.Cache) func(w http.ResponseWriter, r
 // @Description Get PersistentVolume by UID
 // @Tags storage
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/storage/persistentvolumes/{uid} [get]
 // @Param uid path string false "Get persistentvolume by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPersistentVolume(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PersistentVolumes)
 }
 
+// @Description Get PersistentVolume by name
+// @Tags storage
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/storage/persistentvolumes/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getPersistentVolumeByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.PersistentVolumes)
+}
+
+// @Description Delete PersistentVolume by UID
+// @Tags storage
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/storage/persistentvolumes/{uid} [delete]
+// @Param uid path string true "PersistentVolume uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deletePersistentVolume(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.PersistentVolumes, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get PersistentVolumeClaims
 // @Tags storage
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/storage/persistentvolumeclaims [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPersistentVolumeClaims(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PersistentVolumeClaims)
 }
@@ -824,29 +2041,69 @@ func getPersistentVolumeClaims(cache *resources.Cache) func(w http.ResponseWrite
 // @Description Get PersistentVolumeClaim by UID
 // @Tags storage
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/storage/persistentvolumeclaims/{uid} [get]
 // @Param uid path string false "Get persistentvolumeclaim by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getPersistentVolumeClaim(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.PersistentVolumeClaims)
 }
 
+// @Description Get PersistentVolumeClaim by namespace and name
+// @Tags storage
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/storage/persistentvolumeclaims/ns/{namespace}/name/{name} [get]
+// @Param namespace path string true "Namespace of the resource"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getPersistentVolumeClaimByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.PersistentVolumeClaims)
+}
+
+// @Description Delete PersistentVolumeClaim by UID
+// @Tags storage
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/storage/persistentvolumeclaims/{uid} [delete]
+// @Param uid path string true "PersistentVolumeClaim uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deletePersistentVolumeClaim(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.PersistentVolumeClaims, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get StorageClasses
 // @Tags storage
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/storage/storageclasses [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getStorageClasses(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.StorageClasses)
 }
@@ -854,18 +2111,48 @@ func getStorageClasses(cache *resources.Cache) func(w http.ResponseWriter, r *ht
 // @Description Get StorageClass by UID
 // @Tags storage
 // @Accept  html
-// @Produce  json
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/storage/storageclasses/{uid} [get]
 // @Param uid path string false "Get storageclass by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getStorageClass(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.StorageClasses)
 }
 
+// @Description Get StorageClass by name
+// @Tags storage
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/storage/storageclasses/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getStorageClassByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.StorageClasses)
+}
+
+// @Description Delete StorageClass by UID
+// @Tags storage
+// @Produce  json
+// @Success 200
+// @Router /api/v1/resources/storage/storageclasses/{uid} [delete]
+// @Param uid path string true "StorageClass uid"
+// @Param dryRun query bool false "Run the delete as a server-side dry run"
+func deleteStorageClass(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDelete(cache.StorageClasses, clients.DynamicClient, clients.RESTMapper)
+}
+
 // @Description Get Cluster Connection Status
 // @Tags cluster-connection-status
 // @Produce text/event-stream
@@ -875,17 +2162,47 @@ func checkClusterConnection(k8sSession *session.K8sSession) http.HandlerFunc {
 	return k8sSession.ServeConnStatus()
 }
 
+// @Description Get the kubeconfig contexts and the one the runtime is connected to
+// @Tags contexts
+// @Produce json
+// @Success 200 {object} session.ContextsResponse
+// @Router /api/v1/contexts [get]
+func getContexts(k8sSession *session.K8sSession) http.HandlerFunc {
+	return k8sSession.ServeContexts()
+}
+
+// @Description Switch the runtime to another kubeconfig context. Open streams end with a cluster-switched event and should be reopened.
+// @Tags contexts
+// @Produce json
+// @Success 200 {object} session.ContextsResponse
+// @Failure 400 {string} string "Missing context name or running in-cluster"
+// @Failure 404 {string} string "Context not found"
+// @Router /api/v1/contexts/switch [post]
+// @Param name query string true "Name of the kubeconfig context"
+func switchContext(k8sSession *session.K8sSession) http.HandlerFunc {
+	return k8sSession.ServeSwitchContext()
+}
+
 // @Description Get Custom Resource Definitions
 // @Tags resources
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/custom-resource-defintions [get]
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getCRDs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.CRDs)
 }
@@ -893,19 +2210,105 @@ func getCRDs(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request
 // @Description Get Custom Resource Definition by UID
 // @Tags resources
 // @Accept  html
-// @Produce text/event-stream,json
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
 // @Success 200
 // @Router /api/v1/resources/custom-resource-defintions/{uid} [get]
 // @Param uid path string false "Get CRD by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
 // @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
 // @Param dense query bool false "Send the data in dense format"
 // @Param namespace query string false "Filter by namespace"
 // @Param name query string false "Filter by name (partial match)"
-// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
 func getCRD(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	return rest.Bind(cache.CRDs)
 }
 
+// @Description Get Custom Resource Definition by name
+// @Tags resources
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/custom-resource-definitions/name/{name} [get]
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getCRDByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.Bind(cache.CRDs)
+}
+
+// @Description Get Custom Resources by group, version and resource
+// @Tags resources
+// @Accept  html
+// @Produce text/event-stream,json,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/custom/{group}/{version}/{resource} [get]
+// @Param group path string true "API group of the custom resource"
+// @Param version path string true "API version of the custom resource"
+// @Param resource path string true "Plural resource name of the custom resource"
+// @Param once query bool false "Send the data once and close the connection. By default this is set to`false` and will return a text/event-stream. If set to `true` the response content type is application/json."
+// @Param dense query bool false "Send the data in dense format"
+// @Param namespace query string false "Filter by namespace"
+// @Param name query string false "Filter by name (partial match)"
+// @Param labelSelector query string false "Filter by label selector. Format: app=foo,tier!=db"
+// @Param fieldSelector query string false "Filter by field selector on any path. Format: status.phase=Running,spec.nodeName=node-1"
+// @Param sort query string false "Sort by a JSONPath, resources are otherwise ordered by namespace and name. Format: .metadata.creationTimestamp"
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Param limit query int false "Maximum number of resources per page, the response includes the total count and a continue token"
+// @Param continue query string false "Continue token from the previous page"
+// @Param mode query string false "Stream mode, delta sends a snapshot followed by ADDED, MODIFIED and DELETED events with IDs, reconnecting with Last-Event-ID replays missed events" Enums(full, delta)
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getCustomResources(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDynamicCustomResource(cache.CustomResources)
+}
+
+// @Description Get Custom Resource by UID
+// @Tags resources
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/custom/{group}/{version}/{resource}/{uid} [get]
+// @Param group path string true "API group of the custom resource"
+// @Param version path string true "API version of the custom resource"
+// @Param resource path string true "Plural resource name of the custom resource"
+// @Param uid path string false "Get custom resource by uid"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and the stream ends with a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getCustomResource(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDynamicCustomResource(cache.CustomResources)
+}
+
+// @Description Get Custom Resource by namespace and name
+// @Tags resources
+// @Accept  html
+// @Produce json,text/event-stream,application/yaml,text/csv,application/x-ndjson
+// @Success 200
+// @Router /api/v1/resources/custom/{group}/{version}/{resource}/ns/{namespace}/name/{name} [get]
+// @Router /api/v1/resources/custom/{group}/{version}/{resource}/name/{name} [get]
+// @Param group path string true "API group of the custom resource"
+// @Param version path string true "API version of the custom resource"
+// @Param resource path string true "Plural resource name of the custom resource"
+// @Param namespace path string false "Namespace of the resource, omitted for cluster-scoped resources"
+// @Param name path string true "Name of the resource"
+// @Param stream query bool false "Stream the resource as SSE, it is sent again whenever it changes and as a deleted event when it is removed"
+// @Param format query string false "Response format, table returns kubectl-style rows with printer columns for the kind, or the additionalPrinterColumns of the CRD for custom resources" Enums(table)
+// @Param output query string false "Output format of once and single resource responses, also negotiated with the Accept header. yaml is apply-ready with status and server metadata stripped, csv has a column per field" Enums(json, yaml, csv, ndjson)
+// @Param dense query bool false "Send the data in dense format"
+// @Param fields query string false "Filter by fields. Format: .metadata.labels.app,.metadata.name,.spec.containers[].name,.status. Also supports JSONPath-style expressions such as .spec.containers[0].image, .metadata.labels.app\.kubernetes\.io/name and .status.conditions[?(@.type=='Ready')].status, and aliases such as ready:.status.conditions[?(@.type=='Ready')].status"
+func getCustomResourceByName(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
+	return rest.BindDynamicCustomResource(cache.CustomResources)
+}
+
 // @Description Handle auth when running in local mode
 // @Tags auth
 // @Success 200
@@ -21,7 +21,7 @@ func Auth(next http.Handler) http.Handler {
 		}
 		if config.LocalAuthEnabled {
 			// only /api/ and /swagger behind auth
-			if strings.HasPrefix(r.URL.Path, "/api/") || strings.HasPrefix(r.URL.Path, "/swagger") {
+			if requiresAuth(r.URL.Path) {
 				// check if the request is in the allow list
 				for _, path := range apiAllowList {
 					if r.URL.Path == path {
@@ -37,10 +37,21 @@ func Auth(next http.Handler) http.Handler {
 				return
 			}
 		} else if config.InClusterAuthEnabled {
-			if valid := clusterAuth.ValidateJWT(w, r); valid {
-				next.ServeHTTP(w, r)
+			// only /api/ and /swagger behind auth, /healthz and the UI assets are served without a token
+			// and runtime metrics are scraped by Prometheus and protected by their own token
+			if requiresAuth(r.URL.Path) {
+				// the policy decides which routes and verbs are allowed, and may limit the request to some namespaces
+				if scopedReq, valid := clusterAuth.Authorize(w, r); valid {
+					next.ServeHTTP(w, scopedReq)
+				}
+				return
 			}
 		}
 		next.ServeHTTP(w, r)
 	})
 }
+
+// requiresAuth returns whether the path is behind auth, only the API and its docs are
+func requiresAuth(path string) bool {
+	return strings.HasPrefix(path, "/api/") || strings.HasPrefix(path, "/swagger")
+}
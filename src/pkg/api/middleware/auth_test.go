@@ -124,6 +124,38 @@ func TestAuthMiddleware(t *testing.T) {
 			expectedStatusCode:   http.StatusUnauthorized,
 			setup:                func(*http.Request) {},
 		},
+		{
+			name:                 "In-cluster auth - Metrics are exempt",
+			localAuthEnabled:     false,
+			inClusterAuthEnabled: true,
+			path:                 "/metrics",
+			expectedStatusCode:   http.StatusOK,
+			setup:                func(*http.Request) {},
+		},
+		{
+			name:                 "In-cluster auth - Health check is exempt",
+			localAuthEnabled:     false,
+			inClusterAuthEnabled: true,
+			path:                 "/healthz",
+			expectedStatusCode:   http.StatusOK,
+			setup:                func(*http.Request) {},
+		},
+		{
+			name:                 "In-cluster auth - UI assets are exempt",
+			localAuthEnabled:     false,
+			inClusterAuthEnabled: true,
+			path:                 "/_app/immutable/entry/start.js",
+			expectedStatusCode:   http.StatusOK,
+			setup:                func(*http.Request) {},
+		},
+		{
+			name:                 "In-cluster auth - swagger is behind auth",
+			localAuthEnabled:     false,
+			inClusterAuthEnabled: true,
+			path:                 "/swagger/index.html",
+			expectedStatusCode:   http.StatusUnauthorized,
+			setup:                func(*http.Request) {},
+		},
 		{
 			name:                 "Both Auths Disabled",
 			localAuthEnabled:     false,
@@ -161,3 +193,71 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddlewarePolicy(t *testing.T) {
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	createToken := func(groups []string) string {
+		jot := jwt.New(jwt.SigningMethodNone)
+		jot.Claims = jwt.MapClaims{
+			"groups": groups,
+		}
+		token, _ := jot.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		return token
+	}
+
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		token              string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Admin can delete",
+			method:             http.MethodDelete,
+			path:               "/api/v1/resources/workloads/pods/1",
+			token:              createToken([]string{"/UDS Core/Admin"}),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Auditor can read",
+			method:             http.MethodGet,
+			path:               "/api/v1/resources/workloads/pods/1",
+			token:              createToken([]string{"/UDS Core/Auditor"}),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Auditor cannot delete",
+			method:             http.MethodDelete,
+			path:               "/api/v1/resources/workloads/pods/1",
+			token:              createToken([]string{"/UDS Core/Auditor"}),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Auditor cannot scale",
+			method:             http.MethodPost,
+			path:               "/api/v1/resources/workloads/deployments/1/scale",
+			token:              createToken([]string{"/UDS Core/Auditor"}),
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.LocalAuthEnabled = false
+			config.InClusterAuthEnabled = true
+
+			req, err := http.NewRequest(tt.method, tt.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", tt.token)
+
+			rr := httptest.NewRecorder()
+			Auth(nextHandler).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+		})
+	}
+}
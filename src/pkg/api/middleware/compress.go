@@ -30,7 +30,9 @@ func (w *gzipResponseWriter) Flush() {
 // ConditionalCompress compresses the response if the client supports it
 func ConditionalCompress(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		// WebSocket upgrades need the underlying writer to hijack the connection, so they are never compressed
+		isUpgrade := strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+		if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") && !isUpgrade {
 			w.Header().Set("Content-Encoding", "gzip")
 			gz := gzip.NewWriter(w)
 			defer gz.Close()
@@ -17,6 +17,7 @@ func TestConditionalCompress(t *testing.T) {
 	tests := []struct {
 		name             string
 		acceptEncoding   string
+		upgrade          string
 		expectedEncoding string
 		expectedBody     string
 	}{
@@ -32,6 +33,13 @@ func TestConditionalCompress(t *testing.T) {
 			expectedEncoding: "",
 			expectedBody:     "Hello, World!",
 		},
+		{
+			name:             "WebSocket upgrade is not compressed",
+			acceptEncoding:   "gzip",
+			upgrade:          "websocket",
+			expectedEncoding: "",
+			expectedBody:     "Hello, World!",
+		},
 	}
 
 	for _, tt := range tests {
@@ -44,6 +52,9 @@ func TestConditionalCompress(t *testing.T) {
 			if tt.acceptEncoding != "" {
 				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
 			}
+			if tt.upgrade != "" {
+				req.Header.Set("Upgrade", tt.upgrade)
+			}
 
 			rr := httptest.NewRecorder()
 			handler.ServeHTTP(rr, req)
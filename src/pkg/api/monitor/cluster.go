@@ -28,6 +28,12 @@ type ClusterData struct {
 func BindClusterOverviewHandler(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request) {
 	// Return a function that sends the data to the client
 	return func(w http.ResponseWriter, r *http.Request) {
+		historyRange, err := parseHistoryRange(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
 		rest.WriteHeaders(w)
 		// Ensure the ResponseWriter supports flushing
 		flusher, ok := w.(http.Flusher)
@@ -47,7 +53,8 @@ func BindClusterOverviewHandler(cache *resources.Cache) func(w http.ResponseWrit
 			// Get the current usage
 			clusterData.CurrentUsage.CPU, clusterData.CurrentUsage.Memory = cache.PodMetrics.GetUsage()
 			// Get the historical usage
-			clusterData.HistoricalUsage = cache.PodMetrics.GetHistoricalUsage()
+			from, to := historyRange()
+			clusterData.HistoricalUsage = cache.PodMetrics.GetHistoricalUsageRange(from, to)
 
 			// Load node data
 			nodes := cache.Nodes.GetSparseResources("", "")
@@ -104,6 +111,12 @@ func BindClusterOverviewHandler(cache *resources.Cache) func(w http.ResponseWrit
 		ticker := time.NewTicker(5 * time.Second)
 		defer ticker.Stop()
 
+		// Subscribe to changes before sending the initial data so none are missed
+		metricsChanges, unsubscribeMetrics := cache.MetricsChanges.Subscribe()
+		defer unsubscribeMetrics()
+		nodeChanges, unsubscribeNodes := cache.Nodes.Changes.Subscribe()
+		defer unsubscribeNodes()
+
 		// Send the initial data
 		getUsage(cache)
 
@@ -114,17 +127,56 @@ func BindClusterOverviewHandler(cache *resources.Cache) func(w http.ResponseWrit
 				return
 
 			// If there is a pending update, send the data immediately
-			case <-cache.MetricsChanges:
+			case <-metricsChanges:
 				getUsage(cache)
 
 			// Respond to node changes
-			case <-cache.Nodes.Changes:
+			case <-nodeChanges:
 				getUsage(cache)
 			}
 		}
 	}
 }
 
+// parseHistoryRange returns a function giving the time range of historical usage to send
+// The range is either the last `range` duration, re-evaluated on each update, or fixed by RFC 3339 `from` and `to` times
+func parseHistoryRange(r *http.Request) (func() (time.Time, time.Time), error) {
+	query := r.URL.Query()
+
+	if value := query.Get("range"); value != "" {
+		if query.Get("from") != "" || query.Get("to") != "" {
+			return nil, fmt.Errorf("range cannot be used with from or to")
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			return nil, fmt.Errorf("invalid range %q, must be a positive duration such as 6h", value)
+		}
+		return func() (time.Time, time.Time) {
+			return time.Now().Add(-duration), time.Time{}
+		}, nil
+	}
+
+	var from, to time.Time
+	for name, t := range map[string]*time.Time{"from": &from, "to": &to} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q, must be an RFC 3339 time", name, value)
+		}
+		*t = parsed
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, fmt.Errorf("to must not be before from")
+	}
+
+	return func() (time.Time, time.Time) {
+		return from, to
+	}, nil
+}
+
 func parseMemory(memoryString string) (float64, error) {
 	// Remove the 'i' suffix if present
 	memoryString = strings.TrimSuffix(memoryString, "i")
@@ -4,6 +4,7 @@
 package monitor
 
 import (
+	"bufio"
 	"context"
 	"net/http"
 	"net/http/httptest"
@@ -93,3 +94,134 @@ func TestBindClusterOverviewHandler(t *testing.T) {
 	expectedSubstring := `"totalPods":2`
 	require.Contains(t, rr.Body.String(), expectedSubstring)
 }
+
+func TestParseHistoryRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		expectedFrom time.Time
+		expectedTo   time.Time
+		isValid      bool
+	}{
+		{
+			name:    "No range",
+			isValid: true,
+		},
+		{
+			name:         "From and to",
+			query:        "from=2024-10-01T00:00:00Z&to=2024-10-01T06:00:00Z",
+			expectedFrom: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+			expectedTo:   time.Date(2024, 10, 1, 6, 0, 0, 0, time.UTC),
+			isValid:      true,
+		},
+		{
+			name:         "From only",
+			query:        "from=2024-10-01T00:00:00Z",
+			expectedFrom: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+			isValid:      true,
+		},
+		{
+			name:  "Invalid from",
+			query: "from=yesterday",
+		},
+		{
+			name:  "To before from",
+			query: "from=2024-10-01T06:00:00Z&to=2024-10-01T00:00:00Z",
+		},
+		{
+			name:  "Invalid range",
+			query: "range=-1h",
+		},
+		{
+			name:  "Range with from",
+			query: "range=1h&from=2024-10-01T00:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("GET", "/cluster-overview?"+tt.query, nil)
+			require.NoError(t, err)
+
+			historyRange, err := parseHistoryRange(req)
+			if !tt.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			from, to := historyRange()
+			require.True(t, tt.expectedFrom.Equal(from))
+			require.True(t, tt.expectedTo.Equal(to))
+		})
+	}
+
+	// A relative range is re-evaluated on each update
+	req, err := http.NewRequest("GET", "/cluster-overview?range=1h", nil)
+	require.NoError(t, err)
+	historyRange, err := parseHistoryRange(req)
+	require.NoError(t, err)
+	from, to := historyRange()
+	require.WithinDuration(t, time.Now().Add(-time.Hour), from, time.Second)
+	require.True(t, to.IsZero())
+}
+
+func TestBindClusterOverviewHandlerInvalidRange(t *testing.T) {
+	req, err := http.NewRequest("GET", "/cluster-overview?range=forever", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+
+	BindClusterOverviewHandler(&resources.Cache{})(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestBindClusterOverviewHandlerConcurrentStreams(t *testing.T) {
+	cache := &resources.Cache{
+		PodMetrics: resources.NewPodMetrics(),
+		Pods:       &resources.ResourceList{Resources: map[string]*unstructured.Unstructured{}},
+		Nodes:      &resources.ResourceList{Resources: map[string]*unstructured.Unstructured{}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(BindClusterOverviewHandler(cache)))
+	defer server.Close()
+
+	// Open several streams and read their initial data
+	const streams = 3
+	var readers []*bufio.Reader
+	for i := 0; i < streams; i++ {
+		resp, err := http.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		reader := bufio.NewReader(resp.Body)
+		readOverviewEvent(t, reader)
+		readers = append(readers, reader)
+	}
+	require.Equal(t, streams, cache.MetricsChanges.Subscribers())
+	require.Equal(t, streams, cache.Nodes.Changes.Subscribers())
+
+	// Every stream is updated on metrics and node changes
+	cache.MetricsChanges.Notify()
+	for _, reader := range readers {
+		readOverviewEvent(t, reader)
+	}
+	cache.Nodes.Changes.Notify()
+	for _, reader := range readers {
+		readOverviewEvent(t, reader)
+	}
+}
+
+// readOverviewEvent reads the next SSE event of a cluster overview stream
+func readOverviewEvent(t *testing.T, reader *bufio.Reader) {
+	read := make(chan string, 1)
+	go func() {
+		line, _ := reader.ReadString('\n')
+		_, _ = reader.ReadString('\n')
+		read <- line
+	}()
+
+	select {
+	case line := <-read:
+		require.Contains(t, line, `"totalPods":0`)
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for the cluster overview")
+	}
+}
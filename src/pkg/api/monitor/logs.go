@@ -0,0 +1,223 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/rest"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/stream"
+	"github.com/go-chi/chi/v5"
+	"github.com/zarf-dev/zarf/src/pkg/message"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BindPodLogsHandler streams the logs of a single pod, looked up by UID, as SSE
+func BindPodLogsHandler(pods *resources.ResourceList, clientset kubernetes.Interface) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pod, found := pods.GetResource(chi.URLParam(r, "uid"))
+		if !found || !cluster.NamespaceAllowed(r.Context(), pod.GetNamespace()) {
+			http.Error(w, "Resource not found", http.StatusNotFound)
+			return
+		}
+
+		if container := r.URL.Query().Get("container"); container != "" && !podHasContainer(pod, container) {
+			http.Error(w, fmt.Sprintf("container %s not found in pod %s", container, pod.GetName()), http.StatusBadRequest)
+			return
+		}
+
+		logStream, err := newLogStream(w, r, clientset, pod.GetNamespace())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		logStream.stream.FieldSelector = fields.OneTermEqualSelector("metadata.name", pod.GetName()).String()
+
+		logStream.serve(r.Context())
+	}
+}
+
+// BindWorkloadLogsHandler streams the logs of every pod matching a workload's selector as SSE
+// Each line is prefixed with the pod and container it came from
+func BindWorkloadLogsHandler(workloads *resources.ResourceList, clientset kubernetes.Interface) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		workload, found := workloads.GetResource(chi.URLParam(r, "uid"))
+		if !found || !cluster.NamespaceAllowed(r.Context(), workload.GetNamespace()) {
+			http.Error(w, "Resource not found", http.StatusNotFound)
+			return
+		}
+
+		selector, err := workloadSelector(workload)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+
+		logStream, err := newLogStream(w, r, clientset, workload.GetNamespace())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		logStream.stream.LabelSelector = selector
+		logStream.stream.Prefix = true
+
+		logStream.serve(r.Context())
+	}
+}
+
+// logStream ties a log stream to the SSE response it is written to
+type logStream struct {
+	w            http.ResponseWriter
+	bufferWriter *bufferWriter
+	stream       *stream.Stream
+}
+
+// newLogStream creates a plain text log stream configured from the request's query params
+func newLogStream(w http.ResponseWriter, r *http.Request, clientset kubernetes.Interface, namespace string) (*logStream, error) {
+	query := r.URL.Query()
+
+	// Ensure the ResponseWriter supports flushing
+	if _, ok := w.(http.Flusher); !ok {
+		return nil, fmt.Errorf("streaming unsupported")
+	}
+	bufferWriter := newBufferWriter(w)
+
+	logs := stream.NewStream(bufferWriter, stream.NewTextReader(query.Get("container")), namespace)
+	logs.Client = clientset
+	logs.Follow = query.Get("follow") != "false"
+	logs.Timestamps = query.Get("timestamps") == "true"
+	logs.Previous = query.Get("previous") == "true"
+
+	if since := query.Get("since"); since != "" {
+		parsed, err := time.ParseDuration(since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since duration: %v", err)
+		}
+		logs.Since = parsed
+	}
+
+	if tailLines := query.Get("tailLines"); tailLines != "" {
+		parsed, err := strconv.ParseInt(tailLines, 10, 64)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid tailLines: %s", tailLines)
+		}
+		logs.TailLines = &parsed
+	}
+
+	return &logStream{w: w, bufferWriter: bufferWriter, stream: logs}, nil
+}
+
+// serve runs the log stream and flushes it to the client until the stream ends or the client disconnects
+func (l *logStream) serve(requestCtx context.Context) {
+	ctx, cancel := context.WithCancel(requestCtx)
+	defer cancel()
+
+	// Set the headers for streaming
+	rest.WriteHeaders(l.w)
+
+	// Start the stream in a goroutine and signal when it completes
+	done := make(chan error, 1)
+	go func() {
+		done <- l.stream.Start(ctx)
+	}()
+
+	// Create a timer to send keep-alive messages
+	keepAliveTimer := time.NewTimer(2 * time.Second)
+	defer keepAliveTimer.Stop()
+
+	// Create a ticker to flush the buffer
+	flushTicker := time.NewTicker(time.Second)
+	defer flushTicker.Stop()
+
+	for {
+		select {
+		// Check if the client has disconnected
+		case <-requestCtx.Done():
+			return
+
+		// Handle keep-alive messages
+		case <-keepAliveTimer.C:
+			keepAliveTimer.Reset(30 * time.Second)
+			l.bufferWriter.KeepAlive()
+
+		// Flush every second if there is data
+		case <-flushTicker.C:
+			if err := l.flush(); err != nil {
+				message.WarnErr(err, "Failed to flush buffer")
+				return
+			}
+
+		// Flush any remaining data once the stream ends, e.g. when not following
+		case err := <-done:
+			if err != nil {
+				//nolint:errcheck
+				l.bufferWriter.Write([]byte(fmt.Sprintf("Error: %v", err)))
+			}
+			if err := l.flush(); err != nil {
+				message.WarnErr(err, "Failed to flush buffer")
+			}
+			return
+		}
+	}
+}
+
+// flush writes any buffered log lines to the client
+func (l *logStream) flush() error {
+	l.bufferWriter.mutex.Lock()
+	empty := l.bufferWriter.buffer.Len() == 0
+	l.bufferWriter.mutex.Unlock()
+
+	if empty {
+		return nil
+	}
+	return l.bufferWriter.Flush(l.w)
+}
+
+// podHasContainer returns whether the pod has a container, init container or ephemeral container with the name
+func podHasContainer(pod unstructured.Unstructured, name string) bool {
+	for _, field := range []string{"containers", "initContainers", "ephemeralContainers"} {
+		containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", field)
+		for _, container := range containers {
+			if container, ok := container.(map[string]interface{}); ok && container["name"] == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// workloadSelector returns the label selector of a Deployment, StatefulSet or DaemonSet as a string
+func workloadSelector(workload unstructured.Unstructured) (string, error) {
+	rawSelector, found, err := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if err != nil || !found {
+		return "", fmt.Errorf("%s %s has no selector", workload.GetKind(), workload.GetName())
+	}
+
+	var labelSelector metaV1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, &labelSelector); err != nil {
+		return "", fmt.Errorf("invalid selector: %v", err)
+	}
+
+	selector, err := metaV1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return "", fmt.Errorf("invalid selector: %v", err)
+	}
+
+	// An empty selector would match every pod in the namespace
+	if selector.Empty() {
+		return "", fmt.Errorf("%s %s has an empty selector", workload.GetKind(), workload.GetName())
+	}
+
+	return selector.String(), nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package monitor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestBindLogsHandlers(t *testing.T) {
+	// Create a fake clientset with a pod, the fake client always returns "fake logs" as the log stream
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo-1", Namespace: "podinfo", Labels: map[string]string{"app": "podinfo"}},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "podinfo"}},
+		},
+	})
+
+	pods := &resources.ResourceList{Resources: map[string]*unstructured.Unstructured{
+		"pod-1": {Object: map[string]interface{}{
+			"kind":     "Pod",
+			"metadata": map[string]interface{}{"name": "podinfo-1", "namespace": "podinfo", "uid": "pod-1"},
+			"spec": map[string]interface{}{
+				"containers":     []interface{}{map[string]interface{}{"name": "podinfo"}},
+				"initContainers": []interface{}{map[string]interface{}{"name": "init"}},
+			},
+		}},
+	}}
+
+	deployments := &resources.ResourceList{Resources: map[string]*unstructured.Unstructured{
+		"deploy-1": {Object: map[string]interface{}{
+			"kind":     "Deployment",
+			"metadata": map[string]interface{}{"name": "podinfo", "namespace": "podinfo", "uid": "deploy-1"},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "podinfo"}},
+			},
+		}},
+		"deploy-2": {Object: map[string]interface{}{
+			"kind":     "Deployment",
+			"metadata": map[string]interface{}{"name": "no-selector", "namespace": "podinfo", "uid": "deploy-2"},
+		}},
+	}}
+
+	r := chi.NewRouter()
+	r.Get("/pods/{uid}/logs", BindPodLogsHandler(pods, clientset))
+	r.Get("/deployments/{uid}/logs", BindWorkloadLogsHandler(deployments, clientset))
+
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Pod logs",
+			url:            "/pods/pod-1/logs?follow=false",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "data: fake logs\n\n",
+		},
+		{
+			name:           "Pod container logs",
+			url:            "/pods/pod-1/logs?follow=false&container=podinfo",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "data: fake logs\n\n",
+		},
+		{
+			name:           "Pod init container logs",
+			url:            "/pods/pod-1/logs?follow=false&container=init",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "data: fake logs\n\n",
+		},
+		{
+			name:           "Container not found",
+			url:            "/pods/pod-1/logs?container=missing",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "container missing not found in pod podinfo-1\n",
+		},
+		{
+			name:           "Pod not found",
+			url:            "/pods/missing/logs",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Resource not found\n",
+		},
+		{
+			name:           "Invalid tailLines",
+			url:            "/pods/pod-1/logs?tailLines=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid tailLines: abc\n",
+		},
+		{
+			name:           "Invalid since",
+			url:            "/pods/pod-1/logs?since=yesterday",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid since duration",
+		},
+		{
+			name:           "Deployment logs are prefixed",
+			url:            "/deployments/deploy-1/logs?follow=false&tailLines=10&since=5m",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "data: [podinfo-1/podinfo] fake logs\n\n",
+		},
+		{
+			name:           "Deployment without selector",
+			url:            "/deployments/deploy-2/logs",
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   "Deployment no-selector has no selector\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.url, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.expectedBody)
+		})
+	}
+}
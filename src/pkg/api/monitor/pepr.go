@@ -12,12 +12,15 @@ import (
 	"time"
 
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/rest"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/telemetry"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/pepr"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/stream"
 	"github.com/go-chi/chi/v5"
 	"github.com/zarf-dev/zarf/src/pkg/message"
 )
 
+var peprStreams = telemetry.NewGauge("uds_runtime_pepr_streams", "Number of running Pepr streams")
+
 // @Description Get Pepr data
 // @Tags monitor
 // @Accept  html
@@ -58,8 +61,12 @@ func Pepr(w http.ResponseWriter, r *http.Request) {
 
 	// Start the stream in a goroutine
 	message.Debug("Starting parent pepr stream goroutine")
-	//nolint:errcheck
-	go peprStream.Start(ctx)
+	peprStreams.Inc()
+	go func() {
+		defer peprStreams.Dec()
+		//nolint:errcheck
+		peprStream.Start(ctx)
+	}()
 
 	// Create a timer to send keep-alive messages
 	keepAliveTimer := time.NewTimer(2 * time.Second)
@@ -0,0 +1,73 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"sync"
+)
+
+// Broadcaster notifies every subscriber of changes
+// Each subscriber has its own channel that coalesces notifications until they are received, so slow subscribers
+// never block the notifier or miss that something changed
+// Subscribers may limit the notifications to changes of a key, such as the UID of a single resource
+// The zero value is ready to use
+type Broadcaster struct {
+	mutex sync.Mutex
+	// subscribers maps the channel of each subscriber to its key, empty for every change
+	subscribers map[chan struct{}]string
+}
+
+// Subscribe returns a channel receiving a value after each change and a function to end the subscription
+func (b *Broadcaster) Subscribe() (<-chan struct{}, func()) {
+	return b.SubscribeKey("")
+}
+
+// SubscribeKey returns a channel receiving a value after each change of the key and a function to end the subscription
+// Changes notified without a key are received too, as they may affect any key
+func (b *Broadcaster) SubscribeKey(key string) (<-chan struct{}, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.subscribers == nil {
+		b.subscribers = make(map[chan struct{}]string)
+	}
+	changes := make(chan struct{}, 1)
+	b.subscribers[changes] = key
+
+	return changes, func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		delete(b.subscribers, changes)
+	}
+}
+
+// Notify notifies every subscriber of a change
+func (b *Broadcaster) Notify() {
+	b.NotifyKey("")
+}
+
+// NotifyKey notifies the subscribers to every change and the subscribers to the key of a change of the key
+// An empty key notifies every subscriber
+func (b *Broadcaster) NotifyKey(key string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for changes, subscribed := range b.subscribers {
+		if key != "" && subscribed != "" && subscribed != key {
+			continue
+		}
+		// A pending notification already covers this change
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Subscribers returns the number of subscribers
+func (b *Broadcaster) Subscribers() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.subscribers)
+}
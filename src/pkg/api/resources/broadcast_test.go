@@ -0,0 +1,107 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcaster(t *testing.T) {
+	var b Broadcaster
+
+	first, unsubscribeFirst := b.Subscribe()
+	second, unsubscribeSecond := b.Subscribe()
+	defer unsubscribeSecond()
+	require.Equal(t, 2, b.Subscribers())
+
+	// Every subscriber is notified
+	b.Notify()
+	require.Len(t, first, 1)
+	require.Len(t, second, 1)
+
+	// Notifications coalesce until they are received
+	b.Notify()
+	require.Len(t, first, 1)
+	<-first
+	<-second
+	require.Empty(t, first)
+
+	// Unsubscribed channels are no longer notified
+	unsubscribeFirst()
+	require.Equal(t, 1, b.Subscribers())
+	b.Notify()
+	require.Empty(t, first)
+	require.Len(t, second, 1)
+}
+
+func TestBroadcasterKeys(t *testing.T) {
+	var b Broadcaster
+
+	all, unsubscribeAll := b.Subscribe()
+	defer unsubscribeAll()
+	first, unsubscribeFirst := b.SubscribeKey("1")
+	defer unsubscribeFirst()
+	second, unsubscribeSecond := b.SubscribeKey("2")
+	defer unsubscribeSecond()
+
+	// Only the subscribers to every change and to the key are notified
+	b.NotifyKey("1")
+	require.Len(t, all, 1)
+	require.Len(t, first, 1)
+	require.Empty(t, second)
+	<-all
+	<-first
+
+	// Changes without a key notify every subscriber
+	b.Notify()
+	require.Len(t, all, 1)
+	require.Len(t, first, 1)
+	require.Len(t, second, 1)
+}
+
+func TestBroadcasterConcurrentSubscribers(t *testing.T) {
+	const subscribers = 10
+	const changes = 100
+
+	var b Broadcaster
+	var received sync.WaitGroup
+	received.Add(subscribers)
+	acknowledged := make(chan struct{})
+
+	for i := 0; i < subscribers; i++ {
+		notifications, unsubscribe := b.Subscribe()
+		go func() {
+			defer unsubscribe()
+			defer received.Done()
+			for i := 0; i < changes; i++ {
+				select {
+				case <-notifications:
+					acknowledged <- struct{}{}
+				case <-time.After(time.Second):
+					t.Errorf("subscriber missed change %d", i)
+					return
+				}
+			}
+		}()
+	}
+
+	// Each subscriber observes every change, waiting for all of them before the next change
+	for i := 0; i < changes; i++ {
+		b.Notify()
+		for j := 0; j < subscribers; j++ {
+			select {
+			case <-acknowledged:
+			case <-time.After(time.Second):
+				require.FailNow(t, "timed out waiting for subscribers", "change %d", i)
+			}
+		}
+	}
+
+	received.Wait()
+	require.Zero(t, b.Subscribers())
+}
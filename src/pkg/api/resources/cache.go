@@ -9,12 +9,15 @@ import (
 	"log"
 	"time"
 
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/k8s/client"
 	admissionRegV1 "k8s.io/api/admissionregistration/v1"
 	appsV1 "k8s.io/api/apps/v1"
+	authorizationV1 "k8s.io/api/authorization/v1"
 	autoScalingV2 "k8s.io/api/autoscaling/v2"
 	batchV1 "k8s.io/api/batch/v1"
 	coreV1 "k8s.io/api/core/v1"
+	networkingV1 "k8s.io/api/networking/v1"
 	nodeV1 "k8s.io/api/node/v1"
 	policyV1 "k8s.io/api/policy/v1"
 	schedulingV1 "k8s.io/api/scheduling/v1"
@@ -24,6 +27,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	dynamicInformer "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -34,6 +38,13 @@ type Cache struct {
 	factory        informers.SharedInformerFactory
 	dynamicFactory dynamicInformer.DynamicSharedInformerFactory
 
+	// Per-namespace factories for namespaced kinds, only set when the cache is limited to some namespaces
+	namespacedFactories        []informers.SharedInformerFactory
+	namespacedDynamicFactories []dynamicInformer.DynamicSharedInformerFactory
+
+	// canList reports whether RBAC allows listing a cluster-scoped resource, everything is assumed allowed when nil
+	canList func(gvr schema.GroupVersionResource) bool
+
 	// Core resources
 	Events     *ResourceList
 	Namespaces *ResourceList
@@ -77,19 +88,50 @@ type Cache struct {
 	StorageClasses         *ResourceList
 
 	// Metrics
-	PodMetrics     *PodMetrics
-	MetricsChanges chan struct{}
+	PodMetrics         *PodMetrics
+	MetricsChanges     Broadcaster
+	NodeMetrics        *NodeMetrics
+	NodeMetricsChanges Broadcaster
 
 	// CustomResourceDefinitions
 	CRDs *ResourceList
+
+	// Custom resources started on demand
+	CustomResources *CustomResources
+}
+
+// resourceLists returns the lists of the cached kinds, custom resources started on demand are not included
+func (c *Cache) resourceLists() []*ResourceList {
+	return []*ResourceList{
+		c.Events, c.Namespaces, c.Nodes,
+		c.Pods, c.Deployments, c.Daemonsets, c.Statefulsets, c.Jobs, c.CronJobs,
+		c.UDSPackages, c.UDSExemptions,
+		c.Configmaps, c.Secrets,
+		c.MutatingWebhooks, c.ValidatingWebhooks, c.HPAs, c.PriorityClasses, c.RuntimeClasses,
+		c.PodDisruptionBudgets, c.LimitRanges, c.ResourceQuotas,
+		c.Services, c.NetworkPolicies, c.Endpoints, c.VirtualServices,
+		c.PersistentVolumes, c.PersistentVolumeClaims, c.StorageClasses,
+		c.CRDs,
+	}
 }
 
 func NewCache(ctx context.Context, clients *client.Clients) (*Cache, error) {
+	// Usage history is kept per cluster so it outlives the cache
+	history, err := historyFor(clients.Config.Host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open usage history: %v", err)
+	}
+
+	metricsSource, err := newMetricsSource(clients.MetricsClient.MetricsV1beta1())
+	if err != nil {
+		return nil, err
+	}
+
 	c := &Cache{
-		factory:        informers.NewSharedInformerFactory(clients.Clientset, time.Minute*10),
-		stopper:        make(chan struct{}),
-		PodMetrics:     NewPodMetrics(),
-		MetricsChanges: make(chan struct{}, 1),
+		factory:     informers.NewSharedInformerFactory(clients.Clientset, time.Minute*10),
+		stopper:     make(chan struct{}),
+		PodMetrics:  NewPodMetricsWithHistory(history),
+		NodeMetrics: NewNodeMetrics(),
 	}
 
 	// Create the dynamic client and factory
@@ -99,7 +141,23 @@ func NewCache(ctx context.Context, clients *client.Clients) (*Cache, error) {
 	}
 	c.dynamicFactory = dynamicInformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, time.Minute*10, metaV1.NamespaceAll, nil)
 
+	// When limited to some namespaces, watch namespaced kinds with a factory per namespace
+	// and only watch cluster-scoped kinds that RBAC allows
+	namespaces := config.WatchNamespaces
+	for _, namespace := range namespaces {
+		c.namespacedFactories = append(c.namespacedFactories,
+			informers.NewSharedInformerFactoryWithOptions(clients.Clientset, time.Minute*10, informers.WithNamespace(namespace)))
+		c.namespacedDynamicFactories = append(c.namespacedDynamicFactories,
+			dynamicInformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, time.Minute*10, namespace, nil))
+	}
+	if len(namespaces) > 0 {
+		c.canList = func(gvr schema.GroupVersionResource) bool {
+			return canList(ctx, clients.Clientset, gvr)
+		}
+	}
+
 	c.bindCoreResources()
+	c.CustomResources = NewCustomResources(dynamicClient, c.CRDs, namespaces)
 	c.bindWorkloadResources()
 	c.bindUDSResources()
 	c.bindConfigResources()
@@ -107,9 +165,15 @@ func NewCache(ctx context.Context, clients *client.Clients) (*Cache, error) {
 	c.bindNetworkResources()
 	c.bindStorageResources()
 
-	// start the informer
+	// start the informers
 	go c.factory.Start(c.stopper)
 	go c.dynamicFactory.Start(c.stopper)
+	for _, factory := range c.namespacedFactories {
+		go factory.Start(c.stopper)
+	}
+	for _, factory := range c.namespacedDynamicFactories {
+		go factory.Start(c.stopper)
+	}
 
 	// Wait for the pod cache to sync as it is required for metrics collection
 	if !cache.WaitForCacheSync(ctx.Done(), c.Pods.HasSynced) {
@@ -117,7 +181,10 @@ func NewCache(ctx context.Context, clients *client.Clients) (*Cache, error) {
 	}
 
 	// Start metrics collection
-	go c.StartMetricsCollection(ctx, clients.MetricsClient.MetricsV1beta1())
+	go c.StartMetricsCollection(ctx, metricsSource)
+
+	// Stop idle custom resource informers
+	c.CustomResources.StartReaper(ctx)
 
 	// Stop the informer when the context is done
 	go func() {
@@ -128,23 +195,92 @@ func NewCache(ctx context.Context, clients *client.Clients) (*Cache, error) {
 	return c, nil
 }
 
+// canList uses a SelfSubjectAccessReview to check whether RBAC allows listing the resource cluster-wide
+func canList(ctx context.Context, clientset kubernetes.Interface, gvr schema.GroupVersionResource) bool {
+	review := &authorizationV1.SelfSubjectAccessReview{
+		Spec: authorizationV1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationV1.ResourceAttributes{
+				Verb:     "list",
+				Group:    gvr.Group,
+				Version:  gvr.Version,
+				Resource: gvr.Resource,
+			},
+		},
+	}
+
+	result, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metaV1.CreateOptions{})
+	if err != nil {
+		log.Printf("Unable to check access to %s, skipping it: %v", gvr.String(), err)
+		return false
+	}
+	if !result.Status.Allowed {
+		log.Printf("Not allowed to list %s, skipping it", gvr.String())
+	}
+	return result.Status.Allowed
+}
+
+// namespacedResourceList creates a ResourceList for a namespaced kind, fed by an informer from each namespaced factory
+func (c *Cache) namespacedResourceList(gvk schema.GroupVersionKind, gvr schema.GroupVersionResource, informer func(informers.SharedInformerFactory) cache.SharedIndexInformer) *ResourceList {
+	if len(c.namespacedFactories) == 0 {
+		return NewDynamicResourceList(informer(c.factory), gvk, gvr)
+	}
+
+	namespacedInformers := make([]cache.SharedIndexInformer, 0, len(c.namespacedFactories))
+	for _, factory := range c.namespacedFactories {
+		namespacedInformers = append(namespacedInformers, informer(factory))
+	}
+	return NewAggregateResourceList(namespacedInformers, gvk, gvr)
+}
+
+// namespacedDynamicResourceList creates a ResourceList for a namespaced custom resource, fed by an informer from each namespaced dynamic factory
+func (c *Cache) namespacedDynamicResourceList(gvk schema.GroupVersionKind, gvr schema.GroupVersionResource) *ResourceList {
+	factories := c.namespacedDynamicFactories
+	if len(factories) == 0 {
+		factories = []dynamicInformer.DynamicSharedInformerFactory{c.dynamicFactory}
+	}
+
+	dynamicInformers := make([]cache.SharedIndexInformer, 0, len(factories))
+	for _, factory := range factories {
+		dynamicInformers = append(dynamicInformers, factory.ForResource(gvr).Informer())
+	}
+
+	resource := NewAggregateResourceList(dynamicInformers, gvk, gvr)
+	resource.crds = c.CRDs
+	for _, informer := range dynamicInformers {
+		c.setWatchErrorHandler(informer, resource)
+	}
+	return resource
+}
+
+// clusterResourceList creates a ResourceList for a cluster-scoped kind, or a forbidden one if RBAC does not allow listing it
+func (c *Cache) clusterResourceList(gvk schema.GroupVersionKind, gvr schema.GroupVersionResource, informer func() cache.SharedIndexInformer) *ResourceList {
+	if c.canList != nil && !c.canList(gvr) {
+		return NewForbiddenResourceList(gvk, gvr)
+	}
+	return NewDynamicResourceList(informer(), gvk, gvr)
+}
+
 func (c *Cache) bindCoreResources() {
 	nodeGVK := coreV1.SchemeGroupVersion.WithKind("Node")
 	eventGVK := coreV1.SchemeGroupVersion.WithKind("Event")
 	namespaceGVK := coreV1.SchemeGroupVersion.WithKind("Namespace")
 	crdGVK := schema.FromAPIVersionAndKind("apiextensions.k8s.io/v1", "CustomResourceDefinition")
 
-	c.Nodes = NewResourceList(c.factory.Core().V1().Nodes().Informer(), nodeGVK)
-	c.Events = NewResourceList(c.factory.Core().V1().Events().Informer(), eventGVK)
-	c.Namespaces = NewResourceList(c.factory.Core().V1().Namespaces().Informer(), namespaceGVK)
+	c.Nodes = c.clusterResourceList(nodeGVK, coreV1.SchemeGroupVersion.WithResource("nodes"), c.factory.Core().V1().Nodes().Informer)
+	c.Events = c.namespacedResourceList(eventGVK, coreV1.SchemeGroupVersion.WithResource("events"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().Events().Informer()
+	})
+	c.Namespaces = c.clusterResourceList(namespaceGVK, coreV1.SchemeGroupVersion.WithResource("namespaces"), c.factory.Core().V1().Namespaces().Informer)
 	crdGVR := schema.GroupVersionResource{
 		Group:    "apiextensions.k8s.io",
 		Version:  "v1",
 		Resource: "customresourcedefinitions",
 	}
-	crdInformer := c.dynamicFactory.ForResource(crdGVR).Informer()
-	c.CRDs = NewResourceList(crdInformer, crdGVK)
-	AddCustomListeners(crdInformer, c)
+	c.CRDs = c.clusterResourceList(crdGVK, crdGVR, func() cache.SharedIndexInformer {
+		crdInformer := c.dynamicFactory.ForResource(crdGVR).Informer()
+		AddCustomListeners(crdInformer, c)
+		return crdInformer
+	})
 }
 
 func (c *Cache) bindWorkloadResources() {
@@ -155,20 +291,36 @@ func (c *Cache) bindWorkloadResources() {
 	jobGVK := batchV1.SchemeGroupVersion.WithKind("Job")
 	cronJobGVK := batchV1.SchemeGroupVersion.WithKind("CronJob")
 
-	c.Pods = NewResourceList(c.factory.Core().V1().Pods().Informer(), podGVK)
-	c.Deployments = NewResourceList(c.factory.Apps().V1().Deployments().Informer(), deploymentGVK)
-	c.Daemonsets = NewResourceList(c.factory.Apps().V1().DaemonSets().Informer(), daemonsetGVK)
-	c.Statefulsets = NewResourceList(c.factory.Apps().V1().StatefulSets().Informer(), statefulsetGVK)
-	c.Jobs = NewResourceList(c.factory.Batch().V1().Jobs().Informer(), jobGVK)
-	c.CronJobs = NewResourceList(c.factory.Batch().V1().CronJobs().Informer(), cronJobGVK)
+	c.Pods = c.namespacedResourceList(podGVK, coreV1.SchemeGroupVersion.WithResource("pods"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().Pods().Informer()
+	})
+	c.Deployments = c.namespacedResourceList(deploymentGVK, appsV1.SchemeGroupVersion.WithResource("deployments"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Apps().V1().Deployments().Informer()
+	})
+	c.Daemonsets = c.namespacedResourceList(daemonsetGVK, appsV1.SchemeGroupVersion.WithResource("daemonsets"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Apps().V1().DaemonSets().Informer()
+	})
+	c.Statefulsets = c.namespacedResourceList(statefulsetGVK, appsV1.SchemeGroupVersion.WithResource("statefulsets"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Apps().V1().StatefulSets().Informer()
+	})
+	c.Jobs = c.namespacedResourceList(jobGVK, batchV1.SchemeGroupVersion.WithResource("jobs"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Batch().V1().Jobs().Informer()
+	})
+	c.CronJobs = c.namespacedResourceList(cronJobGVK, batchV1.SchemeGroupVersion.WithResource("cronjobs"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Batch().V1().CronJobs().Informer()
+	})
 }
 
 func (c *Cache) bindConfigResources() {
 	configMapGVK := coreV1.SchemeGroupVersion.WithKind("ConfigMap")
 	secretGVK := coreV1.SchemeGroupVersion.WithKind("Secret")
 
-	c.Configmaps = NewResourceList(c.factory.Core().V1().ConfigMaps().Informer(), configMapGVK)
-	c.Secrets = NewResourceList(c.factory.Core().V1().Secrets().Informer(), secretGVK)
+	c.Configmaps = c.namespacedResourceList(configMapGVK, coreV1.SchemeGroupVersion.WithResource("configmaps"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().ConfigMaps().Informer()
+	})
+	c.Secrets = c.namespacedResourceList(secretGVK, coreV1.SchemeGroupVersion.WithResource("secrets"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().Secrets().Informer()
+	})
 }
 
 func (c *Cache) bindClusterOpsResources() {
@@ -179,27 +331,43 @@ func (c *Cache) bindClusterOpsResources() {
 	priorityClassGVK := schedulingV1.SchemeGroupVersion.WithKind("PriorityClass")
 	podDisruptionBudgetGVK := policyV1.SchemeGroupVersion.WithKind("PodDisruptionBudget")
 	limitRangesGVK := coreV1.SchemeGroupVersion.WithKind("LimitRange")
-	resourceQuotaGVK := coreV1.SchemeGroupVersion.WithKind("ResourceQuotas")
-
-	c.MutatingWebhooks = NewResourceList(c.factory.Admissionregistration().V1().MutatingWebhookConfigurations().Informer(), mutatingWebhookGVK)
-	c.ValidatingWebhooks = NewResourceList(c.factory.Admissionregistration().V1().ValidatingWebhookConfigurations().Informer(), validatingWebhookGVK)
-	c.HPAs = NewResourceList(c.factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer(), hpaGVK)
-	c.RuntimeClasses = NewResourceList(c.factory.Node().V1().RuntimeClasses().Informer(), runtimeClassGVK)
-	c.PriorityClasses = NewResourceList(c.factory.Scheduling().V1().PriorityClasses().Informer(), priorityClassGVK)
-	c.PodDisruptionBudgets = NewResourceList(c.factory.Policy().V1().PodDisruptionBudgets().Informer(), podDisruptionBudgetGVK)
-	c.LimitRanges = NewResourceList(c.factory.Core().V1().LimitRanges().Informer(), limitRangesGVK)
-	c.ResourceQuotas = NewResourceList(c.factory.Core().V1().ResourceQuotas().Informer(), resourceQuotaGVK)
+	resourceQuotaGVK := coreV1.SchemeGroupVersion.WithKind("ResourceQuota")
+
+	c.MutatingWebhooks = c.clusterResourceList(mutatingWebhookGVK, admissionRegV1.SchemeGroupVersion.WithResource("mutatingwebhookconfigurations"),
+		c.factory.Admissionregistration().V1().MutatingWebhookConfigurations().Informer)
+	c.ValidatingWebhooks = c.clusterResourceList(validatingWebhookGVK, admissionRegV1.SchemeGroupVersion.WithResource("validatingwebhookconfigurations"),
+		c.factory.Admissionregistration().V1().ValidatingWebhookConfigurations().Informer)
+	c.HPAs = c.namespacedResourceList(hpaGVK, autoScalingV2.SchemeGroupVersion.WithResource("horizontalpodautoscalers"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Autoscaling().V2().HorizontalPodAutoscalers().Informer()
+	})
+	c.RuntimeClasses = c.clusterResourceList(runtimeClassGVK, nodeV1.SchemeGroupVersion.WithResource("runtimeclasses"), c.factory.Node().V1().RuntimeClasses().Informer)
+	c.PriorityClasses = c.clusterResourceList(priorityClassGVK, schedulingV1.SchemeGroupVersion.WithResource("priorityclasses"), c.factory.Scheduling().V1().PriorityClasses().Informer)
+	c.PodDisruptionBudgets = c.namespacedResourceList(podDisruptionBudgetGVK, policyV1.SchemeGroupVersion.WithResource("poddisruptionbudgets"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Policy().V1().PodDisruptionBudgets().Informer()
+	})
+	c.LimitRanges = c.namespacedResourceList(limitRangesGVK, coreV1.SchemeGroupVersion.WithResource("limitranges"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().LimitRanges().Informer()
+	})
+	c.ResourceQuotas = c.namespacedResourceList(resourceQuotaGVK, coreV1.SchemeGroupVersion.WithResource("resourcequotas"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().ResourceQuotas().Informer()
+	})
 }
 
 func (c *Cache) bindNetworkResources() {
 	serviceGVK := coreV1.SchemeGroupVersion.WithKind("Service")
-	networkPolicyGVK := coreV1.SchemeGroupVersion.WithKind("NetworkPolicy")
+	networkPolicyGVK := networkingV1.SchemeGroupVersion.WithKind("NetworkPolicy")
 	endpointGVK := coreV1.SchemeGroupVersion.WithKind("Endpoints")
 	isitoVSGVK := schema.FromAPIVersionAndKind("networking.istio.io/v1", "VirtualService")
 
-	c.Services = NewResourceList(c.factory.Core().V1().Services().Informer(), serviceGVK)
-	c.NetworkPolicies = NewResourceList(c.factory.Networking().V1().NetworkPolicies().Informer(), networkPolicyGVK)
-	c.Endpoints = NewResourceList(c.factory.Core().V1().Endpoints().Informer(), endpointGVK)
+	c.Services = c.namespacedResourceList(serviceGVK, coreV1.SchemeGroupVersion.WithResource("services"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().Services().Informer()
+	})
+	c.NetworkPolicies = c.namespacedResourceList(networkPolicyGVK, networkingV1.SchemeGroupVersion.WithResource("networkpolicies"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Networking().V1().NetworkPolicies().Informer()
+	})
+	c.Endpoints = c.namespacedResourceList(endpointGVK, coreV1.SchemeGroupVersion.WithResource("endpoints"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().Endpoints().Informer()
+	})
 
 	// VirtualServices are not part of the core informer factory
 	gvr := schema.GroupVersionResource{
@@ -208,9 +376,7 @@ func (c *Cache) bindNetworkResources() {
 		Resource: "virtualservices",
 	}
 
-	informer := c.dynamicFactory.ForResource(gvr).Informer()
-	c.VirtualServices = NewDynamicResourceList(informer, isitoVSGVK, gvr)
-	c.setWatchErrorHandler(informer, c.VirtualServices)
+	c.VirtualServices = c.namespacedDynamicResourceList(isitoVSGVK, gvr)
 }
 
 func (c *Cache) bindStorageResources() {
@@ -218,9 +384,11 @@ func (c *Cache) bindStorageResources() {
 	persistentVolumeClaimGVK := coreV1.SchemeGroupVersion.WithKind("PersistentVolumeClaim")
 	storageClassGVK := storageV1.SchemeGroupVersion.WithKind("StorageClass")
 
-	c.PersistentVolumes = NewResourceList(c.factory.Core().V1().PersistentVolumes().Informer(), persistentVolumeGVK)
-	c.PersistentVolumeClaims = NewResourceList(c.factory.Core().V1().PersistentVolumeClaims().Informer(), persistentVolumeClaimGVK)
-	c.StorageClasses = NewResourceList(c.factory.Storage().V1().StorageClasses().Informer(), storageClassGVK)
+	c.PersistentVolumes = c.clusterResourceList(persistentVolumeGVK, coreV1.SchemeGroupVersion.WithResource("persistentvolumes"), c.factory.Core().V1().PersistentVolumes().Informer)
+	c.PersistentVolumeClaims = c.namespacedResourceList(persistentVolumeClaimGVK, coreV1.SchemeGroupVersion.WithResource("persistentvolumeclaims"), func(f informers.SharedInformerFactory) cache.SharedIndexInformer {
+		return f.Core().V1().PersistentVolumeClaims().Informer()
+	})
+	c.StorageClasses = c.clusterResourceList(storageClassGVK, storageV1.SchemeGroupVersion.WithResource("storageclasses"), c.factory.Storage().V1().StorageClasses().Informer)
 }
 
 func (c *Cache) bindUDSResources() {
@@ -239,20 +407,15 @@ func (c *Cache) bindUDSResources() {
 		Resource: "exemptions",
 	}
 
-	packageInformer := c.dynamicFactory.ForResource(udsPackageGVR).Informer()
-	c.UDSPackages = NewDynamicResourceList(packageInformer, udsPackageGVK, udsPackageGVR)
-	c.setWatchErrorHandler(packageInformer, c.UDSPackages)
-
-	exemptionInformer := c.dynamicFactory.ForResource(udsExemptionsGVR).Informer()
-	c.UDSExemptions = NewDynamicResourceList(exemptionInformer, udsExemptionGVK, udsExemptionsGVR)
-	c.setWatchErrorHandler(exemptionInformer, c.UDSExemptions)
+	c.UDSPackages = c.namespacedDynamicResourceList(udsPackageGVK, udsPackageGVR)
+	c.UDSExemptions = c.namespacedDynamicResourceList(udsExemptionGVK, udsExemptionsGVR)
 }
 
 // setWatchErrorHandler sets a watch error handler on the provided informer for custom resources
 func (c *Cache) setWatchErrorHandler(informer cache.SharedIndexInformer, resource *ResourceList) {
 	err := informer.SetWatchErrorHandler(func(_ *cache.Reflector, _ error) {
 		resource.CRDExists = HasCRD(resource.GVR, c.CRDs)
-		resource.Changes <- struct{}{}
+		resource.Changes.Notify()
 	})
 	if err != nil {
 		log.Printf("error setting watch error handler: %v", err)
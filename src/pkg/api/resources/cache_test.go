@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	dynamicFake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
@@ -471,7 +472,6 @@ func TestSetWatchErrorHandler(t *testing.T) {
 	crdResource := &ResourceList{
 		Resources:       make(map[string]*unstructured.Unstructured),
 		SparseResources: make(map[string]*unstructured.Unstructured),
-		Changes:         make(chan struct{}, 1),
 		GVR:             crdGVR,
 		CRDExists:       true,
 	}
@@ -488,17 +488,20 @@ func TestSetWatchErrorHandler(t *testing.T) {
 		},
 	}
 
+	changes, unsubscribe := crdResource.Changes.Subscribe()
+	defer unsubscribe()
+
 	c.setWatchErrorHandler(mockInformer, crdResource)
 
 	// Confirm SetWatchErrorHandler sets CRDExists to false
 	require.False(t, crdResource.CRDExists)
 
-	// Check if a change was sent to the Changes channel
+	// Check if subscribers were notified of a change
 	select {
-	case <-crdResource.Changes:
+	case <-changes:
 		// This is the expected behavior
 	case <-time.After(time.Second):
-		t.Errorf("Expected a change to be sent to the Changes channel, but none was received")
+		t.Errorf("Expected subscribers to be notified of a change, but no notification was received")
 	}
 }
 
@@ -511,3 +514,64 @@ type mockSharedIndexInformer struct {
 func (m *mockSharedIndexInformer) SetWatchErrorHandler(handler cache.WatchErrorHandler) error {
 	return m.setWatchErrorHandlerFunc(handler)
 }
+
+func TestNamespacedCache(t *testing.T) {
+	// create fake clients
+	clientset := fake.NewSimpleClientset()
+	runtimeScheme := runtime.NewScheme()
+	runtimeScheme.AddKnownTypeWithName(schema.GroupVersionKind{
+		Group:   "apiextensions.k8s.io",
+		Version: "v1",
+		Kind:    "CustomResourceDefinitionList",
+	}, &unstructured.Unstructured{})
+	dynamicClient := dynamicFake.NewSimpleDynamicClient(runtimeScheme)
+
+	// Create a mock Pod in a watched namespace and one in another namespace
+	for _, namespace := range []string{"team-a", "team-b"} {
+		mockPod := &corev1.Pod{}
+		mockPod.SetName("pod-" + namespace)
+		mockPod.SetNamespace(namespace)
+		mockPod.SetUID(types.UID("uid-" + namespace))
+		_, err := clientset.CoreV1().Pods(namespace).Create(context.Background(), mockPod, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	// Create Cache instance limited to team-a that is only allowed to list namespaces cluster-wide
+	c := &Cache{
+		factory:                    informers.NewSharedInformerFactory(clientset, time.Minute*10),
+		dynamicFactory:             dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, time.Minute*10),
+		namespacedFactories:        []informers.SharedInformerFactory{informers.NewSharedInformerFactoryWithOptions(clientset, time.Minute*10, informers.WithNamespace("team-a"))},
+		namespacedDynamicFactories: []dynamicinformer.DynamicSharedInformerFactory{dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, time.Minute*10, "team-a", nil)},
+		canList: func(gvr schema.GroupVersionResource) bool {
+			return gvr.Resource == "namespaces"
+		},
+		stopper: make(chan struct{}),
+	}
+	defer close(c.stopper)
+
+	// Bind resources
+	c.bindCoreResources()
+	c.bindWorkloadResources()
+
+	// Start informer factories
+	c.factory.Start(c.stopper)
+	c.dynamicFactory.Start(c.stopper)
+	for _, factory := range c.namespacedFactories {
+		factory.Start(c.stopper)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), c.Pods.HasSynced, c.Namespaces.HasSynced))
+
+	// Only pods in the watched namespace are cached
+	pods := c.Pods.GetResources("", "")
+	require.Len(t, pods, 1)
+	require.Equal(t, "pod-team-a", pods[0].GetName())
+
+	// Cluster-scoped kinds RBAC does not allow are forbidden and never populated
+	require.False(t, c.Namespaces.Forbidden)
+	require.True(t, c.Nodes.Forbidden)
+	require.True(t, c.Nodes.HasSynced())
+	require.True(t, c.CRDs.Forbidden)
+}
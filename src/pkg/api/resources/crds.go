@@ -4,6 +4,7 @@
 package resources
 
 import (
+	"errors"
 	"fmt"
 	"log"
 
@@ -11,7 +12,15 @@ import (
 	"k8s.io/client-go/tools/cache"
 )
 
+// ErrCRDNotFound is returned when a custom resource is requested whose CRD does not exist in the cluster
+var ErrCRDNotFound = errors.New("crd not found")
+
+// HasCRD returns whether the CRD for the GVR exists, it is assumed to exist when RBAC does not allow listing CRDs
 func HasCRD(targetGVR schema.GroupVersionResource, CRDs *ResourceList) bool {
+	if CRDs.Forbidden {
+		return true
+	}
+
 	crds := CRDs.GetResources("", "")
 
 	for _, crd := range crds {
@@ -43,39 +52,35 @@ func AddCustomListeners(informer cache.SharedIndexInformer, runtimeCache *Cache)
 }
 
 func notifyCustomResources(c *Cache) {
-	// Send to UDSExemptions channel if initialized
+	// Notify UDSExemptions subscribers if initialized
 	if c.UDSExemptions != nil {
 		c.UDSExemptions.mutex.Lock()
 		defer c.UDSExemptions.mutex.Unlock()
-		select {
-		case c.UDSExemptions.Changes <- struct{}{}:
-		default:
-		}
+		c.UDSExemptions.Changes.Notify()
 	} else {
 		log.Println("UDSExemptions is nil")
 	}
 
-	// Send to UDSPackages channel if initialized
+	// Notify UDSPackages subscribers if initialized
 	if c.UDSPackages != nil {
 		c.UDSPackages.mutex.Lock()
 		defer c.UDSPackages.mutex.Unlock()
-		select {
-		case c.UDSPackages.Changes <- struct{}{}:
-		default:
-		}
+		c.UDSPackages.Changes.Notify()
 	} else {
 		log.Println("UDSPackages is nil")
 	}
 
-	// Send to VirtualServices channel if initialized
+	// Notify VirtualServices subscribers if initialized
 	if c.VirtualServices != nil {
 		c.VirtualServices.mutex.Lock()
 		defer c.VirtualServices.mutex.Unlock()
-		select {
-		case c.VirtualServices.Changes <- struct{}{}:
-		default:
-		}
+		c.VirtualServices.Changes.Notify()
 	} else {
 		log.Println("VirtualServices is nil")
 	}
+
+	// Notify any custom resources that were started on demand
+	if c.CustomResources != nil {
+		c.CustomResources.notifyAll()
+	}
 }
@@ -0,0 +1,207 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicInformer "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// CustomResourceIdleTimeout is how long an informer for a custom resource is kept running after its last use
+const CustomResourceIdleTimeout = 10 * time.Minute
+
+// CustomResources lazily starts and stops dynamic informers for arbitrary custom resources
+type CustomResources struct {
+	mutex       sync.Mutex
+	client      dynamic.Interface
+	crds        *ResourceList
+	resources   map[schema.GroupVersionResource]*customResource
+	idleTimeout time.Duration
+	// namespaces limits namespaced custom resources to these namespaces, all namespaces are watched when empty
+	namespaces []string
+}
+
+// customResource tracks an informer started on demand along with its usage
+type customResource struct {
+	list     *ResourceList
+	stopper  chan struct{}
+	lastUsed time.Time
+	watchers int
+}
+
+// NewCustomResources creates a new CustomResources that resolves kinds from the given CRD list
+// Namespaced custom resources are only watched in the given namespaces, or in all namespaces if none are given
+func NewCustomResources(client dynamic.Interface, crds *ResourceList, namespaces []string) *CustomResources {
+	return &CustomResources{
+		client:      client,
+		crds:        crds,
+		resources:   make(map[schema.GroupVersionResource]*customResource),
+		idleTimeout: CustomResourceIdleTimeout,
+		namespaces:  namespaces,
+	}
+}
+
+// Acquire returns the ResourceList for the given GVR, starting an informer for it if one is not already running.
+// The returned release function must be called once the caller is done with the list so idle informers can be stopped.
+func (cr *CustomResources) Acquire(ctx context.Context, gvr schema.GroupVersionResource) (*ResourceList, func(), error) {
+	cr.mutex.Lock()
+	entry, found := cr.resources[gvr]
+	if !found {
+		gvk, namespaced, err := cr.lookupKind(gvr)
+		if err != nil {
+			cr.mutex.Unlock()
+			return nil, nil, err
+		}
+
+		// Cluster-scoped resources and caches that are not limited to some namespaces use a single informer
+		namespaces := []string{metaV1.NamespaceAll}
+		if namespaced && len(cr.namespaces) > 0 {
+			namespaces = cr.namespaces
+		}
+
+		informers := make([]cache.SharedIndexInformer, 0, len(namespaces))
+		for _, namespace := range namespaces {
+			informers = append(informers, dynamicInformer.NewFilteredDynamicInformer(cr.client, gvr, namespace, time.Minute*10, cache.Indexers{}, nil).Informer())
+		}
+
+		entry = &customResource{
+			list:    NewAggregateResourceList(informers, gvk, gvr),
+			stopper: make(chan struct{}),
+		}
+		entry.list.crds = cr.crds
+		cr.resources[gvr] = entry
+
+		for _, informer := range informers {
+			cr.setWatchErrorHandler(informer, entry.list)
+			go informer.Run(entry.stopper)
+		}
+	}
+	entry.watchers++
+	entry.lastUsed = time.Now()
+	cr.mutex.Unlock()
+
+	release := func() {
+		cr.mutex.Lock()
+		defer cr.mutex.Unlock()
+		entry.watchers--
+		entry.lastUsed = time.Now()
+	}
+
+	// Wait for the informer to sync before handing out the list
+	if !cache.WaitForCacheSync(ctx.Done(), entry.list.HasSynced) {
+		release()
+		return nil, nil, fmt.Errorf("timed out waiting for %s to sync", gvr.String())
+	}
+
+	return entry.list, release, nil
+}
+
+// StartReaper periodically stops informers that have had no watchers for longer than the idle timeout
+func (cr *CustomResources) StartReaper(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				cr.reap(time.Now())
+			case <-ctx.Done():
+				ticker.Stop()
+				cr.stopAll()
+				return
+			}
+		}
+	}()
+}
+
+// reap stops and removes informers that have been idle since before now minus the idle timeout
+func (cr *CustomResources) reap(now time.Time) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	for gvr, entry := range cr.resources {
+		if entry.watchers > 0 || now.Sub(entry.lastUsed) < cr.idleTimeout {
+			continue
+		}
+		log.Printf("Stopping idle informer for %s", gvr.String())
+		close(entry.stopper)
+		delete(cr.resources, gvr)
+	}
+}
+
+// stopAll stops every running informer
+func (cr *CustomResources) stopAll() {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	for gvr, entry := range cr.resources {
+		close(entry.stopper)
+		delete(cr.resources, gvr)
+	}
+}
+
+// notifyAll notifies subscribers of every running custom resource list, e.g. when CRDs change
+func (cr *CustomResources) notifyAll() {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	for _, entry := range cr.resources {
+		entry.list.Changes.Notify()
+	}
+}
+
+// lookupKind resolves the kind and scope for a GVR from its CRD and ensures the requested version is served
+func (cr *CustomResources) lookupKind(gvr schema.GroupVersionResource) (gvk schema.GroupVersionKind, namespaced bool, err error) {
+	name := fmt.Sprintf("%s.%s", gvr.Resource, gvr.Group)
+	crds := cr.crds.GetResources("", name)
+
+	for _, crd := range crds {
+		if crd.GetName() != name {
+			continue
+		}
+
+		kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+		if kind == "" {
+			return schema.GroupVersionKind{}, false, fmt.Errorf("crd %s has no kind", name)
+		}
+		scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+
+		versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		for _, v := range versions {
+			version, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if version["name"] == gvr.Version && version["served"] == true {
+				return gvr.GroupVersion().WithKind(kind), scope != "Cluster", nil
+			}
+		}
+
+		return schema.GroupVersionKind{}, false, fmt.Errorf("%w: version %s of %s is not served", ErrCRDNotFound, gvr.Version, name)
+	}
+
+	return schema.GroupVersionKind{}, false, fmt.Errorf("%w: %s", ErrCRDNotFound, name)
+}
+
+// setWatchErrorHandler marks the list as missing its CRD when the watch fails
+func (cr *CustomResources) setWatchErrorHandler(informer cache.SharedIndexInformer, resource *ResourceList) {
+	err := informer.SetWatchErrorHandler(func(_ *cache.Reflector, _ error) {
+		resource.mutex.Lock()
+		resource.CRDExists = HasCRD(resource.GVR, cr.crds)
+		resource.mutex.Unlock()
+		resource.Changes.Notify()
+	})
+	if err != nil {
+		log.Printf("error setting watch error handler: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+)
+
+func setupCustomResources(t *testing.T, namespaces ...string) (*CustomResources, schema.GroupVersionResource) {
+	widgetGVR := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	// Create fake dynamic client with a mock widget
+	dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		widgetGVR: "WidgetList",
+	})
+
+	mockWidget := &unstructured.Unstructured{}
+	mockWidget.SetAPIVersion("example.com/v1")
+	mockWidget.SetKind("Widget")
+	mockWidget.SetName("test-widget")
+	mockWidget.SetNamespace("default")
+	mockWidget.SetUID("123e4567-e89b-12d3-a456-426614174W1D")
+
+	_, err := dynamicClient.Resource(widgetGVR).Namespace("default").Create(context.Background(), mockWidget, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	otherWidget := mockWidget.DeepCopy()
+	otherWidget.SetName("other-widget")
+	otherWidget.SetNamespace("other")
+	otherWidget.SetUID("123e4567-e89b-12d3-a456-426614174W2D")
+	_, err = dynamicClient.Resource(widgetGVR).Namespace("other").Create(context.Background(), otherWidget, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// Create the CRD list with a CRD for widgets
+	crds := &ResourceList{
+		Resources:       make(map[string]*unstructured.Unstructured),
+		SparseResources: make(map[string]*unstructured.Unstructured),
+		CRDExists:       true,
+	}
+	crds.Resources["crd-1"] = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": "widgets.example.com",
+				"uid":  "crd-1",
+			},
+			"spec": map[string]interface{}{
+				"names": map[string]interface{}{
+					"kind": "Widget",
+				},
+				"versions": []interface{}{
+					map[string]interface{}{"name": "v1", "served": true},
+					map[string]interface{}{"name": "v1beta1", "served": false},
+				},
+			},
+		},
+	}
+
+	return NewCustomResources(dynamicClient, crds, namespaces), widgetGVR
+}
+
+func TestCustomResourcesAcquire(t *testing.T) {
+	cr, widgetGVR := setupCustomResources(t)
+	defer cr.stopAll()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	list, release, err := cr.Acquire(ctx, widgetGVR)
+	require.NoError(t, err)
+	defer release()
+
+	widgets := list.GetResources("default", "")
+	require.Len(t, widgets, 1)
+	require.Equal(t, "test-widget", widgets[0].GetName())
+	require.Equal(t, "Widget", widgets[0].GetKind())
+	require.Len(t, list.GetResources("", ""), 2)
+
+	// A second acquire reuses the running informer
+	again, releaseAgain, err := cr.Acquire(ctx, widgetGVR)
+	require.NoError(t, err)
+	defer releaseAgain()
+	require.Same(t, list, again)
+	require.Equal(t, 2, cr.resources[widgetGVR].watchers)
+}
+
+func TestCustomResourcesAcquireNamespaced(t *testing.T) {
+	cr, widgetGVR := setupCustomResources(t, "default")
+	defer cr.stopAll()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	list, release, err := cr.Acquire(ctx, widgetGVR)
+	require.NoError(t, err)
+	defer release()
+
+	// Only widgets in the watched namespaces are cached
+	widgets := list.GetResources("", "")
+	require.Len(t, widgets, 1)
+	require.Equal(t, "test-widget", widgets[0].GetName())
+}
+
+func TestCustomResourcesAcquireMissingCRD(t *testing.T) {
+	cr, widgetGVR := setupCustomResources(t)
+	defer cr.stopAll()
+
+	// Unknown resource
+	_, _, err := cr.Acquire(context.Background(), schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "gadgets"})
+	require.ErrorIs(t, err, ErrCRDNotFound)
+
+	// Version that is not served
+	widgetGVR.Version = "v1beta1"
+	_, _, err = cr.Acquire(context.Background(), widgetGVR)
+	require.ErrorIs(t, err, ErrCRDNotFound)
+
+	require.Empty(t, cr.resources)
+}
+
+func TestCustomResourcesReap(t *testing.T) {
+	cr, widgetGVR := setupCustomResources(t)
+	defer cr.stopAll()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, release, err := cr.Acquire(ctx, widgetGVR)
+	require.NoError(t, err)
+
+	// Informers with active watchers are never stopped
+	cr.reap(time.Now().Add(2 * cr.idleTimeout))
+	require.Contains(t, cr.resources, widgetGVR)
+
+	release()
+
+	// Recently used informers are kept
+	cr.reap(time.Now())
+	require.Contains(t, cr.resources, widgetGVR)
+
+	// Idle informers are stopped
+	stopper := cr.resources[widgetGVR].stopper
+	cr.reap(time.Now().Add(2 * cr.idleTimeout))
+	require.NotContains(t, cr.resources, widgetGVR)
+
+	select {
+	case <-stopper:
+	default:
+		t.Errorf("Expected the idle informer to be stopped")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"encoding/json"
+	"log"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// encodedResource is the JSON encoding of a resource and its sparse counterpart
+type encodedResource struct {
+	resourceVersion string
+	dense           []byte
+	sparse          []byte
+}
+
+// encodeResource encodes the resource the same way json.Marshal encodes a list of resources
+func encodeResource(resource, sparseResource *unstructured.Unstructured) *encodedResource {
+	dense, err := json.Marshal(resource)
+	if err != nil {
+		log.Printf("Error encoding %s %s: %v\n", resource.GetKind(), resource.GetName(), err)
+		return nil
+	}
+	sparse, err := json.Marshal(sparseResource)
+	if err != nil {
+		log.Printf("Error encoding sparse %s %s: %v\n", resource.GetKind(), resource.GetName(), err)
+		return nil
+	}
+
+	return &encodedResource{
+		resourceVersion: resource.GetResourceVersion(),
+		dense:           dense,
+		sparse:          sparse,
+	}
+}
+
+// CachedJSON returns the cached JSON encoding of each resource, or nil for resources that are not cached,
+// e.g. because they changed since they were read from the list
+func (r *ResourceList) CachedJSON(resources []unstructured.Unstructured, sparse bool) [][]byte {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	encodings := make([][]byte, len(resources))
+	for i := range resources {
+		encoded := r.encoded[string(resources[i].GetUID())]
+		if encoded == nil || encoded.resourceVersion != resources[i].GetResourceVersion() {
+			continue
+		}
+		if sparse {
+			encodings[i] = encoded.sparse
+		} else {
+			encodings[i] = encoded.dense
+		}
+	}
+
+	return encodings
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/defenseunicorns/uds-runtime/src/test"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCachedJSON(t *testing.T) {
+	resourceList := NewResourceList(&fakeSyncedInformer{}, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+
+	pod := test.CreateMockPod("mock-pod-1", "default", "1")
+	pod.SetResourceVersion("1")
+	pod.SetAnnotations(map[string]string{"note": "dense only"})
+	resourceList.notifyChange(pod, Added)
+
+	// The encodings match encoding the resources read from the list
+	dense := resourceList.GetResources("", "")
+	expected, err := json.Marshal(dense)
+	require.NoError(t, err)
+	encodings := resourceList.CachedJSON(dense, false)
+	require.Equal(t, string(expected), "["+string(encodings[0])+"]")
+
+	sparse := resourceList.GetSparseResources("", "")
+	expected, err = json.Marshal(sparse)
+	require.NoError(t, err)
+	encodings = resourceList.CachedJSON(sparse, true)
+	require.Equal(t, string(expected), "["+string(encodings[0])+"]")
+	require.NotContains(t, string(encodings[0]), "dense only")
+
+	// Resyncs keep the encoding
+	cached := resourceList.encoded["1"]
+	resourceList.notifyChange(pod.DeepCopy(), Modified)
+	require.Same(t, cached, resourceList.encoded["1"])
+
+	// Resources that changed since they were read are not cached
+	modified := pod.DeepCopy()
+	modified.SetResourceVersion("2")
+	resourceList.notifyChange(modified, Modified)
+	require.NotSame(t, cached, resourceList.encoded["1"])
+	require.Equal(t, [][]byte{nil}, resourceList.CachedJSON(dense, false))
+
+	// Deleted and unknown resources are not cached
+	resourceList.notifyChange(modified, Deleted)
+	require.Empty(t, resourceList.encoded)
+	require.Equal(t, [][]byte{nil, nil}, resourceList.CachedJSON([]unstructured.Unstructured{*modified, *test.CreateMockPod("mock-pod-2", "default", "2")}, false))
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// eventBuffer is the number of events a subscriber may fall behind before it is dropped
+	eventBuffer = 256
+	// replayLength is the number of recent events kept per list to resume subscriptions from
+	replayLength = 1024
+)
+
+// ResourceEvent is a change to a single resource of a ResourceList
+type ResourceEvent struct {
+	// ID identifies the event, it is unique per list and can be resumed from with Subscribe
+	ID string
+	// Type is Added, Modified or Deleted
+	Type string
+	UID  string
+	// Object is the full resource, for deletions its last known state
+	Object *unstructured.Unstructured
+	// Sparse is the sparse counterpart of Object
+	Sparse *unstructured.Unstructured
+	// Previous is the state of the resource before the change, nil if it was not in the list
+	Previous *unstructured.Unstructured
+}
+
+// Subscription receives the change events of a ResourceList
+type Subscription struct {
+	// Events receives every change after the subscription started
+	// It is closed if the subscriber falls too far behind, it should then resubscribe from the last event it handled
+	Events <-chan ResourceEvent
+	// Resumed is whether the subscription continues from the requested event ID, Replay then holds the events missed since
+	// Otherwise the subscriber must start over from a snapshot of the list
+	Resumed bool
+	Replay  []ResourceEvent
+	// LastEventID is the ID of the last event before the subscription started
+	LastEventID string
+
+	unsubscribe func()
+}
+
+// Unsubscribe ends the subscription
+func (s *Subscription) Unsubscribe() {
+	s.unsubscribe()
+}
+
+// Subscribe returns a subscription to the change events of the list
+// If lastEventID is still in the replay log, the subscription resumes from it
+func (r *ResourceList) Subscribe(lastEventID string) *Subscription {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.subscribers == nil {
+		r.subscribers = make(map[chan ResourceEvent]struct{})
+	}
+	events := make(chan ResourceEvent, eventBuffer)
+	r.subscribers[events] = struct{}{}
+
+	subscription := &Subscription{
+		Events:      events,
+		LastEventID: r.formatEventID(r.eventSequence),
+		unsubscribe: func() {
+			r.mutex.Lock()
+			defer r.mutex.Unlock()
+			if _, ok := r.subscribers[events]; ok {
+				delete(r.subscribers, events)
+				close(events)
+			}
+		},
+	}
+	subscription.Replay, subscription.Resumed = r.eventsSince(lastEventID)
+
+	return subscription
+}
+
+// eventsSince returns the events after the given event ID and whether they are all still in the replay log
+// IDs of other lists, including this list before a restart, cannot be resumed from
+func (r *ResourceList) eventsSince(eventID string) ([]ResourceEvent, bool) {
+	epoch, sequence, found := strings.Cut(eventID, "-")
+	if !found || epoch != r.eventEpoch {
+		return nil, false
+	}
+	since, err := strconv.ParseUint(sequence, 10, 64)
+	if err != nil || since > r.eventSequence || since < r.eventSequence-uint64(len(r.replay)) {
+		return nil, false
+	}
+
+	missed := r.replay[len(r.replay)-int(r.eventSequence-since):]
+	return append([]ResourceEvent(nil), missed...), true
+}
+
+// publish assigns the event its ID, logs it for replay and sends it to the subscribers
+// It must be called with the lock held
+func (r *ResourceList) publish(event ResourceEvent) {
+	r.eventSequence++
+	event.ID = r.formatEventID(r.eventSequence)
+
+	r.replay = append(r.replay, event)
+	if len(r.replay) > replayLength {
+		r.replay[0] = ResourceEvent{}
+		r.replay = r.replay[1:]
+	}
+
+	for events := range r.subscribers {
+		select {
+		case events <- event:
+		default:
+			// Drop subscribers that fell behind rather than blocking the informer
+			delete(r.subscribers, events)
+			close(events)
+		}
+	}
+}
+
+// formatEventID formats the event ID for a sequence number
+// The epoch the list was created at is included, so IDs are not reused after a restart or cluster switch
+func (r *ResourceList) formatEventID(sequence uint64) string {
+	return fmt.Sprintf("%s-%d", r.eventEpoch, sequence)
+}
+
+// newEventEpoch returns a new event epoch based on the current time
+func newEventEpoch() string {
+	return strconv.FormatInt(time.Now().UnixNano(), 36)
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/defenseunicorns/uds-runtime/src/test"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSubscribe(t *testing.T) {
+	resourceList := NewResourceList(&fakeSyncedInformer{}, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	eventID := func(sequence int) string {
+		return fmt.Sprintf("%s-%d", resourceList.eventEpoch, sequence)
+	}
+
+	pod := test.CreateMockPod("mock-pod-1", "default", "1")
+	pod.SetResourceVersion("1")
+	resourceList.notifyChange(pod, Added)
+
+	subscription := resourceList.Subscribe("")
+	require.False(t, subscription.Resumed)
+	require.Equal(t, eventID(1), subscription.LastEventID)
+
+	// Resyncs of unchanged resources are not published
+	resourceList.notifyChange(pod.DeepCopy(), Modified)
+	require.Empty(t, subscription.Events)
+
+	modified := pod.DeepCopy()
+	modified.SetResourceVersion("2")
+	resourceList.notifyChange(modified, Modified)
+	resourceList.notifyChange(modified, Deleted)
+
+	event := <-subscription.Events
+	require.Equal(t, eventID(2), event.ID)
+	require.Equal(t, Modified, event.Type)
+	require.Equal(t, "1", event.UID)
+	require.Equal(t, "2", event.Object.GetResourceVersion())
+	require.Equal(t, "1", event.Previous.GetResourceVersion())
+	require.NotContains(t, event.Sparse.Object, "spec")
+
+	event = <-subscription.Events
+	require.Equal(t, eventID(3), event.ID)
+	require.Equal(t, Deleted, event.Type)
+	require.Equal(t, "mock-pod-1", event.Object.GetName())
+	require.Equal(t, "2", event.Previous.GetResourceVersion())
+
+	subscription.Unsubscribe()
+	_, open := <-subscription.Events
+	require.False(t, open)
+	// Unsubscribing twice is a no-op
+	subscription.Unsubscribe()
+}
+
+func TestSubscribeResume(t *testing.T) {
+	resourceList := NewResourceList(&fakeSyncedInformer{}, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	eventID := func(sequence int) string {
+		return fmt.Sprintf("%s-%d", resourceList.eventEpoch, sequence)
+	}
+
+	for i := 1; i <= replayLength+10; i++ {
+		pod := test.CreateMockPod(fmt.Sprintf("mock-pod-%d", i), "default", fmt.Sprint(i))
+		resourceList.notifyChange(pod, Added)
+	}
+	require.Len(t, resourceList.replay, replayLength)
+
+	tests := []struct {
+		name        string
+		lastEventID string
+		resumed     bool
+		replayed    int
+	}{
+		{name: "No event ID", lastEventID: ""},
+		{name: "Up to date", lastEventID: eventID(replayLength + 10), resumed: true},
+		{name: "Missed events", lastEventID: eventID(replayLength + 8), resumed: true, replayed: 2},
+		{name: "Oldest event in the log", lastEventID: eventID(10), resumed: true, replayed: replayLength},
+		{name: "Aged out", lastEventID: eventID(9)},
+		{name: "Future event", lastEventID: eventID(replayLength + 11)},
+		{name: "Other epoch", lastEventID: fmt.Sprintf("other-%d", replayLength+10)},
+		{name: "Invalid", lastEventID: "invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := resourceList.Subscribe(tt.lastEventID)
+			defer subscription.Unsubscribe()
+
+			require.Equal(t, tt.resumed, subscription.Resumed)
+			require.Equal(t, eventID(replayLength+10), subscription.LastEventID)
+			require.Len(t, subscription.Replay, tt.replayed)
+			if tt.replayed > 0 {
+				require.Equal(t, eventID(replayLength+10-tt.replayed+1), subscription.Replay[0].ID)
+				require.Equal(t, eventID(replayLength+10), subscription.Replay[tt.replayed-1].ID)
+			}
+		})
+	}
+}
+
+func TestSubscribeSlowSubscriber(t *testing.T) {
+	resourceList := NewResourceList(&fakeSyncedInformer{}, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+
+	slow := resourceList.Subscribe("")
+	defer slow.Unsubscribe()
+	fast := resourceList.Subscribe("")
+	defer fast.Unsubscribe()
+
+	for i := 0; i <= eventBuffer; i++ {
+		resourceList.notifyChange(test.CreateMockPod("mock-pod", "default", "1"), Added)
+		if i < eventBuffer {
+			<-fast.Events
+		}
+	}
+
+	// The slow subscriber is dropped once its buffer is full, the others keep receiving events
+	var last ResourceEvent
+	received := 0
+	for event := range slow.Events {
+		last = event
+		received++
+	}
+	require.Equal(t, eventBuffer, received)
+
+	event := <-fast.Events
+	require.Equal(t, fmt.Sprintf("%s-%d", resourceList.eventEpoch, eventBuffer+1), event.ID)
+
+	// The slow subscriber can resume from the last event it received
+	resumed := resourceList.Subscribe(last.ID)
+	defer resumed.Unsubscribe()
+	require.True(t, resumed.Resumed)
+	require.Len(t, resumed.Replay, 1)
+	require.Equal(t, event.ID, resumed.Replay[0].ID)
+}
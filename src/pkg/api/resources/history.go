@@ -0,0 +1,450 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
+)
+
+// HistoryStore keeps usage samples for a cluster
+type HistoryStore interface {
+	// Add records a sample, samples must be added in chronological order
+	Add(sample Usage) error
+	// Range returns the retained samples between from and to, a zero time leaves that end open
+	Range(from, to time.Time) []Usage
+	Close() error
+}
+
+// HistoryOptions configures how long usage history is kept and how it is downsampled
+type HistoryOptions struct {
+	// Retention is how long samples are kept
+	Retention time.Duration
+	// RawRetention is how long samples are kept at full resolution before they are downsampled
+	RawRetention time.Duration
+	// Resolution is the interval older samples are averaged into
+	Resolution time.Duration
+	// Dir is where history is persisted, history is only kept in memory when empty
+	Dir string
+}
+
+// DefaultHistoryOptions keeps a day of history, samples older than an hour are averaged over 5 minutes
+func DefaultHistoryOptions() HistoryOptions {
+	return HistoryOptions{
+		Retention:    24 * time.Hour,
+		RawRetention: time.Hour,
+		Resolution:   5 * time.Minute,
+	}
+}
+
+// Validate ensures the durations are positive and consistent
+func (o HistoryOptions) Validate() error {
+	if o.Retention <= 0 || o.RawRetention <= 0 || o.Resolution <= 0 {
+		return fmt.Errorf("history retention, raw retention and resolution must be positive")
+	}
+	if o.RawRetention > o.Retention {
+		return fmt.Errorf("history raw retention %s must not exceed retention %s", o.RawRetention, o.Retention)
+	}
+	return nil
+}
+
+var (
+	historyMutex   sync.Mutex
+	historyOptions = DefaultHistoryOptions()
+	// histories are kept per cluster so history survives reconnects and context switches
+	histories = make(map[string]HistoryStore)
+)
+
+// ConfigureHistory sets the options of history stores opened afterwards
+func ConfigureHistory(options HistoryOptions) error {
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+	historyOptions = options
+	return nil
+}
+
+// historyFor returns the history store of the cluster, opening it on first use
+func historyFor(cluster string) (HistoryStore, error) {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	if store, found := histories[cluster]; found {
+		return store, nil
+	}
+
+	var store HistoryStore = NewMemoryHistory(historyOptions)
+	if historyOptions.Dir != "" {
+		// Hash the cluster address for a file name that is safe on any filesystem
+		sum := sha256.Sum256([]byte(cluster))
+		path := filepath.Join(historyOptions.Dir, "usage-"+hex.EncodeToString(sum[:8])+".jsonl")
+
+		fileStore, err := OpenFileHistory(path, historyOptions)
+		if err != nil {
+			return nil, err
+		}
+		store = fileStore
+	}
+
+	histories[cluster] = store
+	return store, nil
+}
+
+// usageRing is a fixed capacity ring buffer of samples in chronological order
+type usageRing struct {
+	samples []Usage
+	start   int
+	size    int
+}
+
+func newUsageRing(capacity int) *usageRing {
+	return &usageRing{samples: make([]Usage, capacity)}
+}
+
+// push appends the sample, evicting and returning the oldest one if the ring is full
+func (r *usageRing) push(sample Usage) (evicted Usage, ok bool) {
+	if r.size == len(r.samples) {
+		evicted, ok = r.pop()
+	}
+	r.samples[(r.start+r.size)%len(r.samples)] = sample
+	r.size++
+	return evicted, ok
+}
+
+// oldest returns the oldest sample without removing it
+func (r *usageRing) oldest() (Usage, bool) {
+	if r.size == 0 {
+		return Usage{}, false
+	}
+	return r.samples[r.start], true
+}
+
+// pop removes and returns the oldest sample
+func (r *usageRing) pop() (Usage, bool) {
+	sample, ok := r.oldest()
+	if ok {
+		r.start = (r.start + 1) % len(r.samples)
+		r.size--
+	}
+	return sample, ok
+}
+
+// appendRange appends the samples between from and to to result
+func (r *usageRing) appendRange(result []Usage, from, to time.Time) []Usage {
+	for i := 0; i < r.size; i++ {
+		sample := r.samples[(r.start+i)%len(r.samples)]
+		if inRange(sample.Timestamp, from, to) {
+			result = append(result, sample)
+		}
+	}
+	return result
+}
+
+// inRange returns whether t is between from and to, a zero time leaves that end open
+func inRange(t, from, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || !t.After(to))
+}
+
+// usageBucket collects the samples falling into one downsampling interval
+// The samples are kept until the bucket is complete so an incomplete bucket can be persisted without losing its weight
+type usageBucket struct {
+	start   time.Time
+	samples []Usage
+}
+
+func (b *usageBucket) average() Usage {
+	average := Usage{Timestamp: b.start}
+	for _, sample := range b.samples {
+		average.CPU += sample.CPU
+		average.Memory += sample.Memory
+	}
+	average.CPU /= float64(len(b.samples))
+	average.Memory /= float64(len(b.samples))
+	return average
+}
+
+// MemoryHistory keeps recent samples at full resolution and older samples downsampled, in ring buffers
+type MemoryHistory struct {
+	mutex       sync.RWMutex
+	options     HistoryOptions
+	raw         *usageRing
+	downsampled *usageRing
+	bucket      *usageBucket
+}
+
+// NewMemoryHistory creates an in-memory history store
+func NewMemoryHistory(options HistoryOptions) *MemoryHistory {
+	return &MemoryHistory{
+		options:     options,
+		raw:         newUsageRing(int(options.RawRetention/config.MetricsInterval) + 1),
+		downsampled: newUsageRing(int(options.Retention/options.Resolution) + 1),
+	}
+}
+
+// Add records a sample, moving samples older than the raw retention into the downsampled history
+func (h *MemoryHistory) Add(sample Usage) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if evicted, ok := h.raw.push(sample); ok {
+		h.downsample(evicted)
+	}
+
+	cutoff := sample.Timestamp.Add(-h.options.RawRetention)
+	for oldest, ok := h.raw.oldest(); ok && oldest.Timestamp.Before(cutoff); oldest, ok = h.raw.oldest() {
+		h.raw.pop()
+		h.downsample(oldest)
+	}
+
+	return nil
+}
+
+// downsample adds the sample to the current bucket, completing the bucket once a sample falls outside it
+func (h *MemoryHistory) downsample(sample Usage) {
+	start := sample.Timestamp.Truncate(h.options.Resolution)
+	if h.bucket != nil && !h.bucket.start.Equal(start) {
+		h.downsampled.push(h.bucket.average())
+		h.bucket = nil
+	}
+	if h.bucket == nil {
+		h.bucket = &usageBucket{start: start}
+	}
+	h.bucket.samples = append(h.bucket.samples, sample)
+}
+
+// Range returns the retained samples between from and to, oldest first
+func (h *MemoryHistory) Range(from, to time.Time) []Usage {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	// Drop samples past the retention, the ring capacity alone does not account for gaps
+	if retained := time.Now().Add(-h.options.Retention); from.Before(retained) {
+		from = retained
+	}
+
+	result := h.downsampled.appendRange([]Usage{}, from, to)
+	if h.bucket != nil && inRange(h.bucket.start, from, to) {
+		result = append(result, h.bucket.average())
+	}
+	return h.raw.appendRange(result, from, to)
+}
+
+// persisted returns the retained samples in the order they are replayed when loaded
+// The incomplete bucket is returned as its samples so it is averaged correctly once it completes
+func (h *MemoryHistory) persisted() []Usage {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	retained := time.Now().Add(-h.options.Retention)
+	result := h.downsampled.appendRange([]Usage{}, retained, time.Time{})
+	if h.bucket != nil {
+		result = append(result, h.bucket.samples...)
+	}
+	return h.raw.appendRange(result, retained, time.Time{})
+}
+
+// Close is a no-op for in-memory history
+func (h *MemoryHistory) Close() error {
+	return nil
+}
+
+// FileHistory is a MemoryHistory that persists samples to a file so history survives restarts
+// Samples are appended as JSON lines and the file is periodically rewritten from the downsampled history
+type FileHistory struct {
+	*MemoryHistory
+	mutex    sync.Mutex
+	path     string
+	file     *os.File
+	appended int
+}
+
+// OpenFileHistory loads the history persisted at path, creating the file if needed
+func OpenFileHistory(path string, options HistoryOptions) (*FileHistory, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	h := &FileHistory{MemoryHistory: NewMemoryHistory(options), path: path}
+	if err := h.load(); err != nil {
+		return nil, err
+	}
+	if err := h.compact(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// load replays the persisted samples within the retention
+func (h *FileHistory) load() error {
+	file, err := os.Open(h.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open history: %w", err)
+	}
+	defer file.Close()
+
+	retained := time.Now().Add(-h.options.Retention)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var sample Usage
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			// Skip a line truncated by a crash
+			log.Printf("Skipping invalid history sample in %s: %v", h.path, err)
+			continue
+		}
+		if sample.Timestamp.Before(retained) {
+			continue
+		}
+		//nolint:errcheck
+		h.MemoryHistory.Add(sample)
+	}
+	return scanner.Err()
+}
+
+// compact rewrites the file with the downsampled history and reopens it for appending
+func (h *FileHistory) compact() error {
+	tmpPath := h.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to compact history: %w", err)
+	}
+
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	for _, sample := range h.MemoryHistory.persisted() {
+		if err := encoder.Encode(sample); err != nil {
+			tmp.Close()
+			return fmt.Errorf("failed to compact history: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to compact history: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to compact history: %w", err)
+	}
+	if err := os.Rename(tmpPath, h.path); err != nil {
+		return fmt.Errorf("failed to compact history: %w", err)
+	}
+
+	if h.file != nil {
+		h.file.Close()
+	}
+	h.file, err = os.OpenFile(h.path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open history: %w", err)
+	}
+	h.appended = 0
+	return nil
+}
+
+// Add records the sample and appends it to the file, compacting the file once a raw retention worth of samples was appended
+func (h *FileHistory) Add(sample Usage) error {
+	//nolint:errcheck
+	h.MemoryHistory.Add(sample)
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return err
+	}
+	if _, err := h.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to persist history: %w", err)
+	}
+
+	h.appended++
+	if h.appended >= len(h.raw.samples) {
+		return h.compact()
+	}
+	return nil
+}
+
+// Close closes the history file
+func (h *FileHistory) Close() error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.file.Close()
+}
+
+// resourceHistoryLength is how many samples are kept per pod or node, an hour at the metrics interval
+func resourceHistoryLength() int {
+	return max(int(time.Hour/config.MetricsInterval), 1)
+}
+
+// ResourceHistory keeps a bounded usage history per resource UID, e.g. for sparklines of individual pods and nodes
+type ResourceHistory struct {
+	mutex   sync.RWMutex
+	length  int
+	samples map[string]*usageRing
+}
+
+// NewResourceHistory creates a history keeping up to length samples per resource
+func NewResourceHistory(length int) *ResourceHistory {
+	return &ResourceHistory{
+		length:  length,
+		samples: make(map[string]*usageRing),
+	}
+}
+
+// Add records a sample for the resource, evicting its oldest sample once the history is full
+func (h *ResourceHistory) Add(uid string, sample Usage) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	ring, found := h.samples[uid]
+	if !found {
+		ring = newUsageRing(h.length)
+		h.samples[uid] = ring
+	}
+	ring.push(sample)
+}
+
+// Get returns the samples of the resource, oldest first
+func (h *ResourceHistory) Get(uid string) []Usage {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	ring, found := h.samples[uid]
+	if !found {
+		return []Usage{}
+	}
+	return ring.appendRange([]Usage{}, time.Time{}, time.Time{})
+}
+
+// Delete drops the history of the resource
+func (h *ResourceHistory) Delete(uid string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	delete(h.samples, uid)
+}
+
+// Retain drops the history of resources that are not in uids
+func (h *ResourceHistory) Retain(uids map[string]struct{}) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for uid := range h.samples {
+		if _, found := uids[uid]; !found {
+			delete(h.samples, uid)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+var testHistoryOptions = HistoryOptions{
+	Retention:    time.Hour,
+	RawRetention: 5 * time.Minute,
+	Resolution:   5 * time.Minute,
+}
+
+// addSamples adds a sample every metrics interval, with CPU counting up from 0, ending now
+func addSamples(t *testing.T, store HistoryStore, count int) time.Time {
+	start := time.Now().Add(-time.Duration(count) * config.MetricsInterval).Truncate(testHistoryOptions.Resolution)
+	for i := 0; i < count; i++ {
+		require.NoError(t, store.Add(Usage{Timestamp: start.Add(time.Duration(i) * config.MetricsInterval), CPU: float64(i), Memory: 1}))
+	}
+	return start
+}
+
+func TestMemoryHistory(t *testing.T) {
+	h := NewMemoryHistory(testHistoryOptions)
+	require.Empty(t, h.Range(time.Time{}, time.Time{}))
+
+	// 30 minutes of samples, all but the last 5 minutes are averaged into 5 minute buckets
+	start := addSamples(t, h, 60)
+	history := h.Range(time.Time{}, time.Time{})
+
+	downsampled := history[:4]
+	for i, usage := range downsampled {
+		require.Equal(t, start.Add(time.Duration(i)*testHistoryOptions.Resolution), usage.Timestamp)
+		// The average of the 10 samples in the bucket
+		require.Equal(t, float64(i*10)+4.5, usage.CPU)
+		require.Equal(t, float64(1), usage.Memory)
+	}
+
+	// The incomplete bucket is averaged over the samples evicted so far
+	require.Equal(t, start.Add(4*testHistoryOptions.Resolution), history[4].Timestamp)
+	require.Equal(t, float64(44), history[4].CPU)
+
+	// Recent samples are kept at full resolution
+	raw := history[5:]
+	require.Len(t, raw, 11)
+	require.Equal(t, float64(49), raw[0].CPU)
+	require.Equal(t, float64(59), raw[len(raw)-1].CPU)
+	for i := 1; i < len(history); i++ {
+		require.True(t, history[i].Timestamp.After(history[i-1].Timestamp))
+	}
+
+	// Ranges are inclusive
+	from := start.Add(10 * time.Minute)
+	ranged := h.Range(from, from.Add(5*time.Minute))
+	require.Len(t, ranged, 2)
+	require.Equal(t, float64(24.5), ranged[0].CPU)
+}
+
+func TestMemoryHistoryRetention(t *testing.T) {
+	h := NewMemoryHistory(testHistoryOptions)
+
+	// Samples older than the retention are not returned
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, h.Add(Usage{Timestamp: old, CPU: 1}))
+	require.NoError(t, h.Add(Usage{Timestamp: time.Now(), CPU: 2}))
+
+	history := h.Range(time.Time{}, time.Time{})
+	require.Len(t, history, 1)
+	require.Equal(t, float64(2), history[0].CPU)
+}
+
+func TestUsageRing(t *testing.T) {
+	r := newUsageRing(2)
+	_, evicted := r.push(Usage{CPU: 1})
+	require.False(t, evicted)
+	_, evicted = r.push(Usage{CPU: 2})
+	require.False(t, evicted)
+
+	// The oldest sample is evicted once the ring is full
+	oldest, evicted := r.push(Usage{CPU: 3})
+	require.True(t, evicted)
+	require.Equal(t, float64(1), oldest.CPU)
+
+	samples := r.appendRange(nil, time.Time{}, time.Time{})
+	require.Equal(t, []Usage{{CPU: 2}, {CPU: 3}}, samples)
+}
+
+func TestFileHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history", "usage.jsonl")
+
+	h, err := OpenFileHistory(path, testHistoryOptions)
+	require.NoError(t, err)
+	addSamples(t, h, 60)
+	expected := h.Range(time.Time{}, time.Time{})
+	require.NoError(t, h.Close())
+
+	// History survives a restart
+	reopened, err := OpenFileHistory(path, testHistoryOptions)
+	require.NoError(t, err)
+	defer reopened.Close()
+	reloaded := reopened.Range(time.Time{}, time.Time{})
+	require.Len(t, reloaded, len(expected))
+	for i := range expected {
+		require.True(t, expected[i].Timestamp.Equal(reloaded[i].Timestamp))
+		require.Equal(t, expected[i].CPU, reloaded[i].CPU)
+		require.Equal(t, expected[i].Memory, reloaded[i].Memory)
+	}
+
+	// The file is compacted to the downsampled history, the incomplete bucket keeps its samples
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 4+9+11)
+}
+
+func TestFileHistoryInvalidLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.jsonl")
+	sample := `{"Timestamp":"` + time.Now().Format(time.RFC3339) + `","CPU":1,"Memory":2}`
+	require.NoError(t, os.WriteFile(path, []byte(sample+"\n{\"Timest"), 0600))
+
+	// A line truncated by a crash is skipped
+	h, err := OpenFileHistory(path, testHistoryOptions)
+	require.NoError(t, err)
+	defer h.Close()
+	require.Len(t, h.Range(time.Time{}, time.Time{}), 1)
+}
+
+func TestHistoryOptionsValidate(t *testing.T) {
+	require.NoError(t, DefaultHistoryOptions().Validate())
+	require.Error(t, HistoryOptions{Retention: time.Hour, RawRetention: 2 * time.Hour, Resolution: time.Minute}.Validate())
+	require.Error(t, HistoryOptions{Retention: time.Hour, RawRetention: time.Hour}.Validate())
+}
+
+func TestResourceHistory(t *testing.T) {
+	h := NewResourceHistory(2)
+	require.Empty(t, h.Get("pod-1"))
+
+	h.Add("pod-1", Usage{CPU: 1})
+	h.Add("pod-1", Usage{CPU: 2})
+	h.Add("pod-1", Usage{CPU: 3})
+	h.Add("pod-2", Usage{CPU: 4})
+
+	// Each resource keeps its most recent samples
+	require.Equal(t, []Usage{{CPU: 2}, {CPU: 3}}, h.Get("pod-1"))
+	require.Equal(t, []Usage{{CPU: 4}}, h.Get("pod-2"))
+
+	h.Retain(map[string]struct{}{"pod-2": {}})
+	require.Empty(t, h.Get("pod-1"))
+	require.Len(t, h.Get("pod-2"), 1)
+
+	h.Delete("pod-2")
+	require.Empty(t, h.Get("pod-2"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,51 +26,95 @@ type ResourceList struct {
 	Resources       map[string]*unstructured.Unstructured
 	SparseResources map[string]*unstructured.Unstructured
 	HasSynced       cache.InformerSynced
-	Changes         chan struct{}
+	Changes         Broadcaster
 	gvk             schema.GroupVersionKind
 	GVR             schema.GroupVersionResource
 	CRDExists       bool
+	// Forbidden is set when RBAC does not allow listing the resources, the list is never populated
+	Forbidden bool
+	// crds is set for custom resources, the printer columns of their tables are read from their CRD
+	crds *ResourceList
+	// encoded holds the JSON encoding of each resource by UID
+	encoded map[string]*encodedResource
+	// informers feed the list, their indexers look the resources up by namespace and name
+	informers []cache.SharedIndexInformer
+	// eventEpoch and eventSequence identify the last change event, replay holds the most recent events
+	eventEpoch    string
+	eventSequence uint64
+	replay        []ResourceEvent
+	subscribers   map[chan ResourceEvent]struct{}
 }
 
-// initializeResourceList initializes the common fields of ResourceList.
-func initializeResourceList(informer cache.SharedIndexInformer, gvk schema.GroupVersionKind) *ResourceList {
+// initializeResourceList initializes the common fields of ResourceList and sets up event handlers on each informer.
+func initializeResourceList(informers []cache.SharedIndexInformer, gvk schema.GroupVersionKind) *ResourceList {
 	r := &ResourceList{
 		Resources:       make(map[string]*unstructured.Unstructured),
 		SparseResources: make(map[string]*unstructured.Unstructured),
-		Changes:         make(chan struct{}, 1),
-		HasSynced:       informer.HasSynced,
+		encoded:         make(map[string]*encodedResource),
+		informers:       informers,
 		gvk:             gvk,
 		CRDExists:       true,
 		GVR:             schema.GroupVersionResource{},
+		eventEpoch:      newEventEpoch(),
 	}
 
-	//nolint:errcheck
-	// Handlers to update the ResourceList
-	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj any) {
-			r.notifyChange(obj, Added)
-		},
-		UpdateFunc: func(_, newObj any) {
-			r.notifyChange(newObj, Modified)
-		},
-		DeleteFunc: func(obj any) {
-			r.notifyChange(obj, Deleted)
-		},
-	})
+	synced := make([]cache.InformerSynced, 0, len(informers))
+	for _, informer := range informers {
+		synced = append(synced, informer.HasSynced)
+
+		//nolint:errcheck
+		// Handlers to update the ResourceList
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				r.notifyChange(obj, Added)
+			},
+			UpdateFunc: func(_, newObj any) {
+				r.notifyChange(newObj, Modified)
+			},
+			DeleteFunc: func(obj any) {
+				r.notifyChange(obj, Deleted)
+			},
+		})
+	}
+
+	// The list has synced once every informer feeding it has
+	r.HasSynced = func() bool {
+		for _, hasSynced := range synced {
+			if !hasSynced() {
+				return false
+			}
+		}
+		return true
+	}
 
 	return r
 }
 
 // NewResourceList initializes a ResourceList and sets up event handlers for resource changes.
 func NewResourceList(informer cache.SharedIndexInformer, gvk schema.GroupVersionKind) *ResourceList {
-	r := initializeResourceList(informer, gvk)
+	r := initializeResourceList([]cache.SharedIndexInformer{informer}, gvk)
 	return r
 }
 
 // NewDynamicResourceList initializes a ResourceList with a gvr.
 func NewDynamicResourceList(informer cache.SharedIndexInformer, gvk schema.GroupVersionKind, gvr schema.GroupVersionResource) *ResourceList {
-	r := initializeResourceList(informer, gvk)
+	r := initializeResourceList([]cache.SharedIndexInformer{informer}, gvk)
+	r.GVR = gvr
+	return r
+}
+
+// NewAggregateResourceList initializes a ResourceList fed by several informers, e.g. one per watched namespace.
+func NewAggregateResourceList(informers []cache.SharedIndexInformer, gvk schema.GroupVersionKind, gvr schema.GroupVersionResource) *ResourceList {
+	r := initializeResourceList(informers, gvk)
+	r.GVR = gvr
+	return r
+}
+
+// NewForbiddenResourceList initializes an empty ResourceList for resources RBAC does not allow listing.
+func NewForbiddenResourceList(gvk schema.GroupVersionKind, gvr schema.GroupVersionResource) *ResourceList {
+	r := initializeResourceList(nil, gvk)
 	r.GVR = gvr
+	r.Forbidden = true
 	return r
 }
 
@@ -85,6 +130,30 @@ func (r *ResourceList) GetResource(uid string) (unstructured.Unstructured, bool)
 	return *resource, true
 }
 
+// GetResourceByName returns a resource by namespace and name, the namespace is empty for cluster-scoped resources.
+// The name is resolved to a UID by the informer indexers, so the resource is found once its change has been handled.
+func (r *ResourceList) GetResourceByName(namespace string, name string) (unstructured.Unstructured, bool) {
+	key := name
+	if namespace != "" {
+		key = namespace + "/" + name
+	}
+
+	// Aggregate lists have an informer per namespace, the resource is in at most one of them
+	for _, informer := range r.informers {
+		obj, found, err := informer.GetIndexer().GetByKey(key)
+		if err != nil || !found {
+			continue
+		}
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			continue
+		}
+		return r.GetResource(string(object.GetUID()))
+	}
+
+	return unstructured.Unstructured{}, false
+}
+
 // GetResources returns a slice of the current resources.
 func (r *ResourceList) GetResources(namespace string, namePartial string) []unstructured.Unstructured {
 	r.mutex.RLock()
@@ -117,6 +186,31 @@ func (r *ResourceList) GetSparseResources(namespace string, namePartial string)
 	return resources
 }
 
+// GetSelectedResources returns the resources matching the namespace and name filter and the selector.
+// The selector is evaluated on the full resources, so fields that are not part of the sparse resources can be selected on.
+func (r *ResourceList) GetSelectedResources(namespace string, namePartial string, selector Selector, sparse bool) []unstructured.Unstructured {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	resources := make([]unstructured.Unstructured, 0)
+	for uid, resource := range r.Resources {
+		if !r.isFilterMatch(resource, namespace, namePartial) || !selector.Matches(resource) {
+			continue
+		}
+		if sparse {
+			resource = r.SparseResources[uid]
+		}
+		resources = append(resources, *resource)
+	}
+
+	return resources
+}
+
+// GVK returns the GroupVersionKind of the resources in the list.
+func (r *ResourceList) GVK() schema.GroupVersionKind {
+	return r.gvk
+}
+
 // CRDExistsInCluster returns the value of the MissingCRD field for the ResourceList.
 func (r *ResourceList) CRDExistsInCluster() bool {
 	r.mutex.RLock()
@@ -126,15 +220,17 @@ func (r *ResourceList) CRDExistsInCluster() bool {
 
 // notifyChange updates the ResourceList based on the event type and notifies subscribers of changes.
 func (r *ResourceList) notifyChange(obj interface{}, eventType string) {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
-
 	resource, err := ToUnstructured(obj)
 	if err != nil {
 		// Handle error or log it
 		return
 	}
 
+	// Dynamic informers pass their cached object, it is read by other goroutines and must be copied before it is modified
+	if _, cached := unwrapDeleted(obj).(*unstructured.Unstructured); cached {
+		resource = resource.DeepCopy()
+	}
+
 	// Add GVK because they wont exist without the typed informer
 	resource.SetGroupVersionKind(r.gvk)
 
@@ -151,21 +247,36 @@ func (r *ResourceList) notifyChange(obj interface{}, eventType string) {
 	// Extract the sparse object
 	sparseResource := r.extractSparseObject(resource)
 
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Informer resyncs report unchanged resources as modified, they are not published as events
+	previous, existed := r.Resources[uid]
+	unchanged := eventType == Modified && existed && previous.GetResourceVersion() == resource.GetResourceVersion()
+
 	// Update the resource list based on the event type
 	switch eventType {
 	case Added, Modified:
 		r.Resources[uid] = resource
 		r.SparseResources[uid] = sparseResource
+		// Encode the resource once here rather than on every request, unchanged resources keep their encoding
+		if _, encoded := r.encoded[uid]; !unchanged || !encoded {
+			r.encoded[uid] = encodeResource(resource, sparseResource)
+		}
 	case Deleted:
 		delete(r.Resources, uid)
 		delete(r.SparseResources, uid)
+		delete(r.encoded, uid)
 	}
 
-	// Notify subscribers of the change
-	select {
-	case r.Changes <- struct{}{}:
-	default:
+	cacheEvents.Inc(r.gvk.Kind, eventType)
+
+	if !unchanged {
+		r.publish(ResourceEvent{Type: eventType, UID: uid, Object: resource, Sparse: sparseResource, Previous: previous})
 	}
+
+	// Notify subscribers of the change, subscribers to a single resource are only notified of changes to it
+	r.Changes.NotifyKey(uid)
 }
 
 // isFilterMatch checks if the resource matches the namespace and name filter
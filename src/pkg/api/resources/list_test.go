@@ -9,6 +9,8 @@ import (
 	"github.com/defenseunicorns/uds-runtime/src/test"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
 )
 
 func TestGetResource(t *testing.T) {
@@ -42,6 +44,141 @@ func TestGetResources(t *testing.T) {
 	require.Contains(t, resourceNames, "mock-pod-2")
 }
 
+func TestGetResourceByName(t *testing.T) {
+	informer := newFakeIndexedInformer()
+	resourceList := NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	informer.change(resourceList, test.CreateMockPod("mock-pod", "uds-dev-stack", "1"), Added)
+	informer.change(resourceList, test.CreateMockPod("mock-pod", "default", "2"), Added)
+
+	resource, found := resourceList.GetResourceByName("uds-dev-stack", "mock-pod")
+	require.True(t, found)
+	require.Equal(t, "1", string(resource.GetUID()))
+
+	resource, found = resourceList.GetResourceByName("default", "mock-pod")
+	require.True(t, found)
+	require.Equal(t, "2", string(resource.GetUID()))
+
+	// The lookup follows modifications
+	modified := test.CreateMockPod("mock-pod", "default", "2")
+	modified.SetLabels(map[string]string{"app": "mock"})
+	informer.change(resourceList, modified, Modified)
+	resource, found = resourceList.GetResourceByName("default", "mock-pod")
+	require.True(t, found)
+	require.Equal(t, map[string]string{"app": "mock"}, resource.GetLabels())
+
+	// Deleted resources are no longer found
+	informer.change(resourceList, modified, Deleted)
+	_, found = resourceList.GetResourceByName("default", "mock-pod")
+	require.False(t, found)
+	_, found = resourceList.GetResourceByName("", "mock-pod")
+	require.False(t, found)
+
+	// Resources in the indexer are only found once their change has been handled by the list
+	//nolint:errcheck
+	informer.indexer.Add(test.CreateMockPod("pending-pod", "default", "3"))
+	_, found = resourceList.GetResourceByName("default", "pending-pod")
+	require.False(t, found)
+
+	// Cluster-scoped resources are looked up without a namespace
+	nodeInformer := newFakeIndexedInformer()
+	nodes := NewResourceList(nodeInformer, schema.GroupVersionKind{Version: "v1", Kind: "Node"})
+	node := test.CreateMockPod("mock-node", "", "4")
+	node.SetKind("Node")
+	nodeInformer.change(nodes, node, Added)
+	resource, found = nodes.GetResourceByName("", "mock-node")
+	require.True(t, found)
+	require.Equal(t, "Node", resource.GetKind())
+
+	// Aggregate lists look the resource up in each informer
+	teamA, teamB := newFakeIndexedInformer(), newFakeIndexedInformer()
+	aggregate := NewAggregateResourceList([]cache.SharedIndexInformer{teamA, teamB}, schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, schema.GroupVersionResource{})
+	teamB.change(aggregate, test.CreateMockPod("mock-pod", "team-b", "5"), Added)
+	resource, found = aggregate.GetResourceByName("team-b", "mock-pod")
+	require.True(t, found)
+	require.Equal(t, "5", string(resource.GetUID()))
+
+	// Lists without informers, such as forbidden lists, find nothing
+	_, found = NewForbiddenResourceList(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, schema.GroupVersionResource{}).GetResourceByName("uds-dev-stack", "mock-pod-1")
+	require.False(t, found)
+}
+
+func TestNotifyChangeCopiesCachedObjects(t *testing.T) {
+	resourceList := NewResourceList(&fakeSyncedInformer{}, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+
+	pod := test.CreateMockPod("mock-pod-1", "default", "1")
+	pod.Object["metadata"].(map[string]interface{})["managedFields"] = []interface{}{}
+	cached := pod.DeepCopy()
+
+	// The objects of dynamic informers are shared with their cache, they are left untouched
+	resourceList.notifyChange(pod, Added)
+	resourceList.notifyChange(cache.DeletedFinalStateUnknown{Key: "default/mock-pod-1", Obj: pod}, Deleted)
+	require.Equal(t, cached, pod)
+}
+
+func TestAggregateResourceList(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	teamA := &fakeSyncedInformer{synced: true}
+	teamB := &fakeSyncedInformer{}
+
+	resourceList := NewAggregateResourceList([]cache.SharedIndexInformer{teamA, teamB}, gvk, schema.GroupVersionResource{})
+	require.Equal(t, 2, teamA.handlers+teamB.handlers)
+
+	// The list has synced once every informer has
+	require.False(t, resourceList.HasSynced())
+	teamB.synced = true
+	require.True(t, resourceList.HasSynced())
+}
+
+func TestForbiddenResourceList(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Node"}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+
+	resourceList := NewForbiddenResourceList(gvk, gvr)
+	require.True(t, resourceList.Forbidden)
+	require.True(t, resourceList.HasSynced())
+	require.Equal(t, gvk, resourceList.GVK())
+	require.Equal(t, gvr, resourceList.GVR)
+	require.Empty(t, resourceList.GetResources("", ""))
+}
+
+// fakeSyncedInformer is a SharedIndexInformer whose sync state is set by the test
+type fakeSyncedInformer struct {
+	cache.SharedIndexInformer
+	synced   bool
+	handlers int
+	indexer  cache.Indexer
+}
+
+func (f *fakeSyncedInformer) HasSynced() bool {
+	return f.synced
+}
+
+func (f *fakeSyncedInformer) AddEventHandler(_ cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	f.handlers++
+	return nil, nil
+}
+
+func (f *fakeSyncedInformer) GetIndexer() cache.Indexer {
+	return f.indexer
+}
+
+// newFakeIndexedInformer returns a fakeSyncedInformer with an indexer for the changes made with change
+func newFakeIndexedInformer() *fakeSyncedInformer {
+	return &fakeSyncedInformer{indexer: cache.NewIndexer(cache.DeletionHandlingMetaNamespaceKeyFunc, cache.Indexers{})}
+}
+
+// change applies a change to the indexer and then the list, as the informer does before calling its handlers
+func (f *fakeSyncedInformer) change(r *ResourceList, obj *unstructured.Unstructured, eventType string) {
+	if eventType == Deleted {
+		//nolint:errcheck
+		f.indexer.Delete(obj)
+	} else {
+		//nolint:errcheck
+		f.indexer.Update(obj)
+	}
+	r.notifyChange(obj, eventType)
+}
+
 func setupResourceList() *ResourceList {
 	resourceList := &ResourceList{
 		Resources: make(map[string]*unstructured.Unstructured),
@@ -9,63 +9,44 @@ import (
 	"sync"
 	"time"
 
-	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
-	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
 )
 
-const MAX_HISTORY_LENGTH = 200
-
 type Usage struct {
 	Timestamp time.Time
 	CPU       float64
 	Memory    float64
 }
 
-type PodMetrics struct {
+// resourceMetrics keeps the latest metrics and a bounded usage history per resource UID
+type resourceMetrics struct {
 	sync.RWMutex
 	metrics map[string]*unstructured.Unstructured
-	current struct {
-		CPU    float64
-		Memory float64
-	}
-	historical []Usage
+	usage   *ResourceHistory
 }
 
-func NewPodMetrics() *PodMetrics {
-	return &PodMetrics{
+func newResourceMetrics() resourceMetrics {
+	return resourceMetrics{
 		metrics: make(map[string]*unstructured.Unstructured),
+		usage:   NewResourceHistory(resourceHistoryLength()),
 	}
 }
 
 // GetCount returns the number of metrics in the cache
-func (pm *PodMetrics) GetCount() int {
-	pm.RLock()
-	defer pm.RUnlock()
-	return len(pm.metrics)
-}
-
-// GetUsage returns the current CPU and memory usage
-func (pm *PodMetrics) GetUsage() (cpu float64, mem float64) {
-	pm.RLock()
-	defer pm.RUnlock()
-	return pm.current.CPU, pm.current.Memory
-}
-
-// GetHistoricalUsage returns the historical usage data
-func (pm *PodMetrics) GetHistoricalUsage() []Usage {
-	pm.RLock()
-	defer pm.RUnlock()
-	return pm.historical
+func (rm *resourceMetrics) GetCount() int {
+	rm.RLock()
+	defer rm.RUnlock()
+	return len(rm.metrics)
 }
 
 // GetAll returns all metrics in the cache with optional filtering by namespace, second argument is ignored
-func (pm *PodMetrics) GetAll(namespace string, _ string) []unstructured.Unstructured {
-	pm.RLock()
-	defer pm.RUnlock()
-	result := make([]unstructured.Unstructured, 0, len(pm.metrics))
-	for _, metric := range pm.metrics {
+func (rm *resourceMetrics) GetAll(namespace string, _ string) []unstructured.Unstructured {
+	rm.RLock()
+	defer rm.RUnlock()
+	result := make([]unstructured.Unstructured, 0, len(rm.metrics))
+	for _, metric := range rm.metrics {
 		// Filter by namespace
 		if namespace == "" || metric.GetNamespace() == namespace {
 			result = append(result, *metric)
@@ -75,38 +56,116 @@ func (pm *PodMetrics) GetAll(namespace string, _ string) []unstructured.Unstruct
 	return result
 }
 
-// Update updates the metrics for a pod in the cache
-func (pm *PodMetrics) Update(podUID string, metrics *unstructured.Unstructured) {
-	pm.Lock()
-	defer pm.Unlock()
-	pm.metrics[podUID] = metrics
+// Update updates the metrics for a resource in the cache
+func (rm *resourceMetrics) Update(uid string, metrics *unstructured.Unstructured) {
+	rm.Lock()
+	defer rm.Unlock()
+	rm.metrics[uid] = metrics
+}
+
+// Get returns the metrics for a resource in the cache
+func (rm *resourceMetrics) Get(uid string) *unstructured.Unstructured {
+	rm.RLock()
+	defer rm.RUnlock()
+	return rm.metrics[uid]
+}
+
+// Delete removes the metrics and usage history for a resource from the cache
+func (rm *resourceMetrics) Delete(uid string) {
+	rm.Lock()
+	defer rm.Unlock()
+	delete(rm.metrics, uid)
+	rm.usage.Delete(uid)
+}
+
+// GetResourceUsage returns the usage history of a resource, oldest first
+func (rm *resourceMetrics) GetResourceUsage(uid string) []Usage {
+	return rm.usage.Get(uid)
+}
+
+// record updates the metrics and usage history of a resource
+func (rm *resourceMetrics) record(uid string, metrics *unstructured.Unstructured, usage Usage) {
+	rm.Update(uid, metrics)
+	rm.usage.Add(uid, usage)
+}
+
+// retain removes the metrics and usage history of resources that are not in uids
+func (rm *resourceMetrics) retain(uids map[string]struct{}) {
+	rm.Lock()
+	defer rm.Unlock()
+	for uid := range rm.metrics {
+		if _, found := uids[uid]; !found {
+			delete(rm.metrics, uid)
+		}
+	}
+	rm.usage.Retain(uids)
+}
+
+type PodMetrics struct {
+	resourceMetrics
+	current struct {
+		CPU    float64
+		Memory float64
+	}
+	history HistoryStore
+}
+
+// NewPodMetrics creates pod metrics with in-memory history using the default options
+func NewPodMetrics() *PodMetrics {
+	return NewPodMetricsWithHistory(NewMemoryHistory(DefaultHistoryOptions()))
 }
 
-// Get returns the metrics for a pod in the cache
-func (pm *PodMetrics) Get(podUID string) *unstructured.Unstructured {
+// NewPodMetricsWithHistory creates pod metrics that record their usage history in the store
+func NewPodMetricsWithHistory(history HistoryStore) *PodMetrics {
+	return &PodMetrics{
+		resourceMetrics: newResourceMetrics(),
+		history:         history,
+	}
+}
+
+// GetUsage returns the current CPU and memory usage
+func (pm *PodMetrics) GetUsage() (cpu float64, mem float64) {
 	pm.RLock()
 	defer pm.RUnlock()
-	return pm.metrics[podUID]
+	return pm.current.CPU, pm.current.Memory
+}
+
+// GetHistoricalUsage returns all retained historical usage data
+func (pm *PodMetrics) GetHistoricalUsage() []Usage {
+	return pm.history.Range(time.Time{}, time.Time{})
+}
+
+// GetHistoricalUsageRange returns the historical usage data between from and to, a zero time leaves that end open
+func (pm *PodMetrics) GetHistoricalUsageRange(from, to time.Time) []Usage {
+	return pm.history.Range(from, to)
 }
 
-// Delete removes the metrics for a pod from the cache
-func (pm *PodMetrics) Delete(podUID string) {
-	pm.Lock()
-	defer pm.Unlock()
-	delete(pm.metrics, podUID)
+// NodeMetrics keeps the latest metrics and usage history of each node
+type NodeMetrics struct {
+	resourceMetrics
+}
+
+// NewNodeMetrics creates an empty node metrics cache
+func NewNodeMetrics() *NodeMetrics {
+	return &NodeMetrics{resourceMetrics: newResourceMetrics()}
 }
 
 // StartMetricsCollection starts a goroutine to collect metrics for all pods in the cache
-func (c *Cache) StartMetricsCollection(ctx context.Context, metricsClient metricsv1beta1.MetricsV1beta1Interface) {
+func (c *Cache) StartMetricsCollection(ctx context.Context, source MetricsSource) {
+	// Fill the usage history from sources that keep their own, e.g. when history is not persisted
+	if historySource, ok := source.(UsageHistorySource); ok {
+		c.backfillHistory(ctx, historySource)
+	}
+
 	// Collect metrics immediately
-	c.collectMetrics(ctx, metricsClient)
+	c.collectMetrics(ctx, source)
 
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(config.MetricsInterval)
 	go func() {
 		for {
 			select {
 			case <-ticker.C:
-				c.collectMetrics(ctx, metricsClient)
+				c.collectMetrics(ctx, source)
 			case <-ctx.Done():
 				ticker.Stop()
 				return
@@ -115,6 +174,30 @@ func (c *Cache) StartMetricsCollection(ctx context.Context, metricsClient metric
 	}()
 }
 
+// backfillHistory adds the usage of the history retention to an empty cluster usage history
+func (c *Cache) backfillHistory(ctx context.Context, source UsageHistorySource) {
+	if len(c.PodMetrics.GetHistoricalUsage()) > 0 {
+		return
+	}
+
+	historyMutex.Lock()
+	options := historyOptions
+	historyMutex.Unlock()
+
+	now := time.Now()
+	usage, err := source.UsageRange(ctx, now.Add(-options.Retention), now, options.Resolution)
+	if err != nil {
+		log.Printf("Error backfilling usage history: %v\n", err)
+		return
+	}
+	for _, sample := range usage {
+		if err := c.PodMetrics.history.Add(sample); err != nil {
+			log.Printf("Error recording usage history: %v\n", err)
+			return
+		}
+	}
+}
+
 // Update the CalculateUsage function
 func (c *Cache) CalculateUsage(metrics *v1beta1.PodMetrics) (float64, float64) {
 	var totalCPU, totalMemory float64
@@ -127,33 +210,50 @@ func (c *Cache) CalculateUsage(metrics *v1beta1.PodMetrics) (float64, float64) {
 	return totalCPU, totalMemory
 }
 
-func (c *Cache) collectMetrics(ctx context.Context, metricsClient metricsv1beta1.MetricsV1beta1Interface) {
+func (c *Cache) collectMetrics(ctx context.Context, source MetricsSource) {
 	var totalCPU, totalMemory float64
+	now := time.Now()
+	defer func() {
+		metricsCollectionDuration.Observe(time.Since(now).Seconds())
+	}()
 
-	// Check for metrics server availability
+	// Fetch the metrics of all pods at once, this also checks for metrics source availability
 	metricsServerAvailable := true
-	_, err := metricsClient.NodeMetricses().List(ctx, metaV1.ListOptions{})
+	podMetrics, err := source.PodMetrics(ctx)
 	if err != nil {
 		metricsServerAvailable = false
-		log.Printf("Metrics server is not available: %v", err)
+		metricsCollectionErrors.Inc("pods")
+		log.Printf("Metrics source is not available: %v", err)
 	}
 
 	if metricsServerAvailable {
-		// Fetch all pods
-		pods := c.Pods.GetSparseResources("", "")
+		nodeMetrics, err := source.NodeMetrics(ctx)
+		if err != nil {
+			metricsCollectionErrors.Inc("nodes")
+			log.Printf("Error fetching node metrics: %v\n", err)
+		} else if nodeMetrics != nil {
+			c.collectNodeMetrics(nodeMetrics, now)
+		}
 
-		// Fetch metrics for each pod
+		// Index the running pods by namespace and name to match them with their metrics
+		pods := c.Pods.GetSparseResources("", "")
+		running := make(map[string]struct{}, len(pods))
+		podUIDs := make(map[string]string, len(pods))
 		for _, pod := range pods {
 			// Only collect metrics for running pods
 			phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
 			if phase != "Running" {
 				continue
 			}
+			running[string(pod.GetUID())] = struct{}{}
+			podUIDs[pod.GetNamespace()+"/"+pod.GetName()] = string(pod.GetUID())
+		}
 
-			// Fetch metrics for the pod
-			metrics, err := metricsClient.PodMetricses(pod.GetNamespace()).Get(ctx, pod.GetName(), metaV1.GetOptions{})
-			if err != nil {
-				log.Printf("Error fetching metrics for pod %s/%s: %v\n", pod.GetNamespace(), pod.GetName(), err)
+		for i := range podMetrics {
+			metrics := &podMetrics[i]
+			uid, found := podUIDs[metrics.Namespace+"/"+metrics.Name]
+			if !found {
+				// The pod is not running or not in the cache yet
 				continue
 			}
 
@@ -165,13 +265,16 @@ func (c *Cache) collectMetrics(ctx context.Context, metricsClient metricsv1beta1
 			// Convert the metrics to unstructured
 			converted, err := ToUnstructured(metrics)
 			if err != nil {
-				log.Printf("Error converting metrics for pod %s/%s: %v\n", pod.GetNamespace(), pod.GetName(), err)
+				log.Printf("Error converting metrics for pod %s/%s: %v\n", metrics.Namespace, metrics.Name, err)
 				continue
 			}
 
-			// Update the cache with the new metrics
-			c.PodMetrics.Update(string(pod.GetUID()), converted)
+			// Update the cache with the new metrics and the pod's usage history
+			c.PodMetrics.record(uid, converted, Usage{Timestamp: now, CPU: cpu, Memory: mem})
 		}
+
+		// Drop the metrics and history of pods that are gone or no longer running
+		c.PodMetrics.retain(running)
 	} else {
 		// Set totalCPU and totalMemory to -1 to indicate metrics server is not available
 		totalCPU = -1
@@ -179,8 +282,10 @@ func (c *Cache) collectMetrics(ctx context.Context, metricsClient metricsv1beta1
 	}
 
 	// Add the metrics to the cache and historical usage
+	c.PodMetrics.Lock()
 	c.PodMetrics.current.CPU = totalCPU
 	c.PodMetrics.current.Memory = totalMemory
+	c.PodMetrics.Unlock()
 
 	// Make sure CPU and Memory data for historical usage is not negative for historical data
 	if !metricsServerAvailable {
@@ -188,20 +293,55 @@ func (c *Cache) collectMetrics(ctx context.Context, metricsClient metricsv1beta1
 		totalMemory = 0
 	}
 
-	c.PodMetrics.historical = append(c.PodMetrics.historical, Usage{
-		Timestamp: time.Now(),
+	err = c.PodMetrics.history.Add(Usage{
+		Timestamp: now,
 		CPU:       totalCPU,
 		Memory:    totalMemory,
 	})
-
-	// Limit the historical usage to the maximum length
-	if len(c.PodMetrics.historical) > MAX_HISTORY_LENGTH {
-		c.PodMetrics.historical = c.PodMetrics.historical[len(c.PodMetrics.historical)-MAX_HISTORY_LENGTH:]
+	if err != nil {
+		metricsCollectionErrors.Inc("history")
+		log.Printf("Error recording usage history: %v\n", err)
 	}
 
 	// Notify subscribers of the change
-	select {
-	case c.MetricsChanges <- struct{}{}:
-	default:
+	c.MetricsChanges.Notify()
+}
+
+// collectNodeMetrics updates the node metrics cache and the usage history of each node
+func (c *Cache) collectNodeMetrics(nodeMetrics []v1beta1.NodeMetrics, now time.Time) {
+	// Node metrics are named after their node, key them by the node's UID like the node cache
+	nodeUIDs := make(map[string]string)
+	for _, node := range c.Nodes.GetSparseResources("", "") {
+		nodeUIDs[node.GetName()] = string(node.GetUID())
 	}
+
+	found := make(map[string]struct{}, len(nodeMetrics))
+	for i := range nodeMetrics {
+		metrics := &nodeMetrics[i]
+		uid, ok := nodeUIDs[metrics.Name]
+		if !ok {
+			// The node is not in the cache yet
+			continue
+		}
+		found[uid] = struct{}{}
+
+		converted, err := ToUnstructured(metrics)
+		if err != nil {
+			log.Printf("Error converting metrics for node %s: %v\n", metrics.Name, err)
+			continue
+		}
+
+		// CPU in millicores, memory in bytes
+		c.NodeMetrics.record(uid, converted, Usage{
+			Timestamp: now,
+			CPU:       float64(metrics.Usage.Cpu().MilliValue()),
+			Memory:    float64(metrics.Usage.Memory().Value()),
+		})
+	}
+
+	// Drop the metrics and history of nodes that were removed
+	c.NodeMetrics.retain(found)
+
+	// Notify subscribers of the change
+	c.NodeMetricsChanges.Notify()
 }
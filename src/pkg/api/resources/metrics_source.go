@@ -0,0 +1,94 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
+)
+
+const (
+	// MetricsSourceMetricsServer collects metrics from the metrics.k8s.io API served by metrics-server
+	MetricsSourceMetricsServer = "metrics-server"
+	// MetricsSourcePrometheus collects metrics from the Prometheus HTTP API
+	MetricsSourcePrometheus = "prometheus"
+)
+
+// MetricsSource provides the current resource usage of pods and nodes
+type MetricsSource interface {
+	// PodMetrics returns the metrics of all pods in the watched namespaces, an error means metrics are not available
+	PodMetrics(ctx context.Context) ([]v1beta1.PodMetrics, error)
+	// NodeMetrics returns the metrics of all nodes, nil if node metrics are not collected
+	NodeMetrics(ctx context.Context) ([]v1beta1.NodeMetrics, error)
+}
+
+// UsageHistorySource is implemented by metrics sources that keep their own history
+// It is used to backfill the cluster usage history, e.g. after a restart without persisted history
+type UsageHistorySource interface {
+	// UsageRange returns the total CPU (millicores) and memory (bytes) usage of the pods between from and to, one sample per step
+	UsageRange(ctx context.Context, from, to time.Time, step time.Duration) ([]Usage, error)
+}
+
+// newMetricsSource returns the metrics source selected in the config
+func newMetricsSource(metricsClient metricsv1beta1.MetricsV1beta1Interface) (MetricsSource, error) {
+	switch config.MetricsSource {
+	case "", MetricsSourceMetricsServer:
+		return NewMetricsServerSource(metricsClient), nil
+	case MetricsSourcePrometheus:
+		return NewPrometheusSource(config.PrometheusURL), nil
+	default:
+		return nil, fmt.Errorf("unknown metrics source %q", config.MetricsSource)
+	}
+}
+
+// MetricsServerSource collects metrics from the metrics.k8s.io API
+type MetricsServerSource struct {
+	client metricsv1beta1.MetricsV1beta1Interface
+}
+
+// NewMetricsServerSource creates a metrics source using the metrics.k8s.io client
+func NewMetricsServerSource(client metricsv1beta1.MetricsV1beta1Interface) *MetricsServerSource {
+	return &MetricsServerSource{client: client}
+}
+
+// PodMetrics returns the metrics of all pods in the watched namespaces with one request per namespace
+func (s *MetricsServerSource) PodMetrics(ctx context.Context) ([]v1beta1.PodMetrics, error) {
+	if len(config.WatchNamespaces) == 0 {
+		list, err := s.client.PodMetricses(metaV1.NamespaceAll).List(ctx, metaV1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}
+
+	var items []v1beta1.PodMetrics
+	for _, namespace := range config.WatchNamespaces {
+		list, err := s.client.PodMetricses(namespace).List(ctx, metaV1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, list.Items...)
+	}
+	return items, nil
+}
+
+// NodeMetrics returns the metrics of all nodes
+// Node metrics are cluster-scoped, so they are not collected when the cache is limited to some namespaces
+func (s *MetricsServerSource) NodeMetrics(ctx context.Context) ([]v1beta1.NodeMetrics, error) {
+	if len(config.WatchNamespaces) > 0 {
+		return nil, nil
+	}
+
+	list, err := s.client.NodeMetricses().List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
@@ -12,8 +12,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -61,11 +64,15 @@ func TestPodMetrics(t *testing.T) {
 
 // CustomFakeNodeMetricsInterface implements a fake NodeMetricsInterface
 type CustomFakeNodeMetricsInterface struct {
-	Err error
+	Err   error
+	Items []v1beta1.NodeMetrics
 }
 
 func (f *CustomFakeNodeMetricsInterface) List(ctx context.Context, opts metav1.ListOptions) (*v1beta1.NodeMetricsList, error) {
-	return nil, f.Err
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	return &v1beta1.NodeMetricsList{Items: f.Items}, nil
 }
 
 // We don't need these methods for this test, so we'll leave them unimplemented
@@ -77,18 +84,45 @@ func (f *CustomFakeNodeMetricsInterface) Watch(ctx context.Context, opts metav1.
 	return nil, nil
 }
 
+// CustomFakePodMetricsInterface implements a fake PodMetricsInterface that counts List requests
+type CustomFakePodMetricsInterface struct {
+	Err   error
+	Items []v1beta1.PodMetrics
+	Lists int
+}
+
+func (f *CustomFakePodMetricsInterface) List(ctx context.Context, opts metav1.ListOptions) (*v1beta1.PodMetricsList, error) {
+	f.Lists++
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	return &v1beta1.PodMetricsList{Items: f.Items}, nil
+}
+
+// We don't need these methods for this test, so we'll leave them unimplemented
+func (f *CustomFakePodMetricsInterface) Get(ctx context.Context, name string, options metav1.GetOptions) (*v1beta1.PodMetrics, error) {
+	return nil, fmt.Errorf("pod metrics must be listed")
+}
+
+func (f *CustomFakePodMetricsInterface) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return nil, nil
+}
+
 // CustomFakeMetricsV1beta1Client implements a fake MetricsV1beta1Interface
 type CustomFakeMetricsV1beta1Client struct {
 	FakeNodeMetrics *CustomFakeNodeMetricsInterface
+	FakePodMetrics  *CustomFakePodMetricsInterface
 }
 
 func (f *CustomFakeMetricsV1beta1Client) NodeMetricses() metricsv1beta1.NodeMetricsInterface {
 	return f.FakeNodeMetrics
 }
 
-// We don't need these methods for this test, so we'll leave them unimplemented
 func (f *CustomFakeMetricsV1beta1Client) PodMetricses(namespace string) metricsv1beta1.PodMetricsInterface {
-	return nil
+	if f.FakePodMetrics == nil {
+		f.FakePodMetrics = &CustomFakePodMetricsInterface{}
+	}
+	return f.FakePodMetrics
 }
 
 func (f *CustomFakeMetricsV1beta1Client) RESTClient() rest.Interface {
@@ -97,17 +131,16 @@ func (f *CustomFakeMetricsV1beta1Client) RESTClient() rest.Interface {
 
 func TestCollectMetrics(t *testing.T) {
 
-	expectedError := fmt.Errorf("custom error: unable to list node metrics")
+	expectedError := fmt.Errorf("custom error: unable to list pod metrics")
 
-	fakeNodeMetrics := &CustomFakeNodeMetricsInterface{Err: expectedError}
-	fakeMetricsClient := &CustomFakeMetricsV1beta1Client{FakeNodeMetrics: fakeNodeMetrics}
+	fakePodMetrics := &CustomFakePodMetricsInterface{Err: expectedError}
+	fakeMetricsClient := &CustomFakeMetricsV1beta1Client{FakePodMetrics: fakePodMetrics}
 
 	// Create a test Pods
 	podGVK := coreV1.SchemeGroupVersion.WithKind("Pod")
 	pods := &ResourceList{
 		Resources:       make(map[string]*unstructured.Unstructured),
 		SparseResources: make(map[string]*unstructured.Unstructured),
-		Changes:         make(chan struct{}, 1),
 		HasSynced:       nil,
 		gvk:             podGVK,
 		CRDExists:       true,
@@ -125,16 +158,147 @@ func TestCollectMetrics(t *testing.T) {
 	logOutput := &logCapture{}
 	log.SetOutput(logOutput)
 
-	cache.collectMetrics(ctx, fakeMetricsClient)
+	cache.collectMetrics(ctx, NewMetricsServerSource(fakeMetricsClient))
 
 	require.Equal(t, cache.PodMetrics.current.CPU, float64(-1))
 	require.Equal(t, cache.PodMetrics.current.Memory, float64(-1))
-	require.Equal(t, cache.PodMetrics.historical[0].CPU, float64(0))
-	require.Equal(t, cache.PodMetrics.historical[0].Memory, float64(0))
+	historical := cache.PodMetrics.GetHistoricalUsage()
+	require.Equal(t, historical[0].CPU, float64(0))
+	require.Equal(t, historical[0].Memory, float64(0))
 
 	require.Contains(t, logOutput.String(), expectedError.Error())
 }
 
+func TestCollectPodMetrics(t *testing.T) {
+	podMetrics := func(namespace, name, cpu, memory string) v1beta1.PodMetrics {
+		return v1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Containers: []v1beta1.ContainerMetrics{{
+				Name: "app",
+				Usage: coreV1.ResourceList{
+					coreV1.ResourceCPU:    resource.MustParse(cpu),
+					coreV1.ResourceMemory: resource.MustParse(memory),
+				},
+			}},
+		}
+	}
+	fakePodMetrics := &CustomFakePodMetricsInterface{
+		Items: []v1beta1.PodMetrics{
+			podMetrics("default", "running", "100m", "1Mi"),
+			podMetrics("kube-system", "running", "200m", "2Mi"),
+			podMetrics("default", "pending", "300m", "3Mi"),
+			podMetrics("default", "uncached", "400m", "4Mi"),
+		},
+	}
+	fakeMetricsClient := &CustomFakeMetricsV1beta1Client{
+		FakeNodeMetrics: &CustomFakeNodeMetricsInterface{},
+		FakePodMetrics:  fakePodMetrics,
+	}
+
+	pods := &ResourceList{
+		Resources:       make(map[string]*unstructured.Unstructured),
+		SparseResources: make(map[string]*unstructured.Unstructured),
+		gvk:             coreV1.SchemeGroupVersion.WithKind("Pod"),
+		CRDExists:       true,
+	}
+	for _, pod := range []struct{ namespace, name, phase string }{
+		{"default", "running", "Running"},
+		{"kube-system", "running", "Running"},
+		{"default", "pending", "Pending"},
+	} {
+		uid := pod.namespace + "-" + pod.name
+		pods.SparseResources[uid] = &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"namespace": pod.namespace, "name": pod.name, "uid": uid},
+			"status":   map[string]interface{}{"phase": pod.phase},
+		}}
+	}
+
+	cache := &Cache{
+		Pods:        pods,
+		Nodes:       &ResourceList{SparseResources: make(map[string]*unstructured.Unstructured)},
+		PodMetrics:  NewPodMetrics(),
+		NodeMetrics: NewNodeMetrics(),
+	}
+
+	cache.collectMetrics(context.TODO(), NewMetricsServerSource(fakeMetricsClient))
+
+	// All pod metrics are fetched with a single request and matched by namespace and name
+	require.Equal(t, 1, fakePodMetrics.Lists)
+	require.Equal(t, 2, cache.PodMetrics.GetCount())
+	require.Equal(t, "kube-system", cache.PodMetrics.Get("kube-system-running").GetNamespace())
+	require.Nil(t, cache.PodMetrics.Get("default-pending"))
+	cpu, mem := cache.PodMetrics.GetUsage()
+	require.Equal(t, float64(300), cpu)
+	require.Equal(t, float64(3<<20), mem)
+	require.Len(t, cache.PodMetrics.GetResourceUsage("default-running"), 1)
+
+	// Metrics of deleted pods are pruned
+	delete(pods.SparseResources, "default-running")
+	cache.collectMetrics(context.TODO(), NewMetricsServerSource(fakeMetricsClient))
+	require.Equal(t, 1, cache.PodMetrics.GetCount())
+	require.Nil(t, cache.PodMetrics.Get("default-running"))
+	require.Empty(t, cache.PodMetrics.GetResourceUsage("default-running"))
+}
+
+func TestCollectNodeMetrics(t *testing.T) {
+	nodeMetrics := func(name, cpu, memory string) v1beta1.NodeMetrics {
+		return v1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Usage: coreV1.ResourceList{
+				coreV1.ResourceCPU:    resource.MustParse(cpu),
+				coreV1.ResourceMemory: resource.MustParse(memory),
+			},
+		}
+	}
+	fakeNodeMetrics := &CustomFakeNodeMetricsInterface{
+		Items: []v1beta1.NodeMetrics{nodeMetrics("node-1", "500m", "1Gi"), nodeMetrics("node-2", "1", "2Gi")},
+	}
+	fakeMetricsClient := &CustomFakeMetricsV1beta1Client{FakeNodeMetrics: fakeNodeMetrics}
+
+	newList := func(gvk schema.GroupVersionKind) *ResourceList {
+		return &ResourceList{
+			Resources:       make(map[string]*unstructured.Unstructured),
+			SparseResources: make(map[string]*unstructured.Unstructured),
+			gvk:             gvk,
+			CRDExists:       true,
+		}
+	}
+	nodes := newList(coreV1.SchemeGroupVersion.WithKind("Node"))
+	for _, name := range []string{"node-1", "node-2"} {
+		node := &unstructured.Unstructured{}
+		node.SetName(name)
+		node.SetUID(types.UID(name + "-uid"))
+		nodes.SparseResources[string(node.GetUID())] = node
+	}
+
+	cache := &Cache{
+		Pods:        newList(coreV1.SchemeGroupVersion.WithKind("Pod")),
+		Nodes:       nodes,
+		PodMetrics:  NewPodMetrics(),
+		NodeMetrics: NewNodeMetrics(),
+	}
+	changes, unsubscribe := cache.NodeMetricsChanges.Subscribe()
+	defer unsubscribe()
+
+	cache.collectMetrics(context.TODO(), NewMetricsServerSource(fakeMetricsClient))
+	require.Equal(t, 2, cache.NodeMetrics.GetCount())
+	require.Equal(t, "node-1", cache.NodeMetrics.Get("node-1-uid").GetName())
+	require.Len(t, changes, 1)
+
+	// Each collection adds to the node's usage history
+	cache.collectMetrics(context.TODO(), NewMetricsServerSource(fakeMetricsClient))
+	usage := cache.NodeMetrics.GetResourceUsage("node-1-uid")
+	require.Len(t, usage, 2)
+	require.Equal(t, float64(500), usage[1].CPU)
+	require.Equal(t, float64(1<<30), usage[1].Memory)
+
+	// Removed nodes are dropped along with their history
+	fakeNodeMetrics.Items = fakeNodeMetrics.Items[:1]
+	cache.collectMetrics(context.TODO(), NewMetricsServerSource(fakeMetricsClient))
+	require.Equal(t, 1, cache.NodeMetrics.GetCount())
+	require.Empty(t, cache.NodeMetrics.GetResourceUsage("node-2-uid"))
+}
+
 type logCapture struct {
 	logs []string
 }
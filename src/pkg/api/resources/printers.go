@@ -0,0 +1,626 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	admissionRegV1 "k8s.io/api/admissionregistration/v1"
+	appsV1 "k8s.io/api/apps/v1"
+	autoScalingV2 "k8s.io/api/autoscaling/v2"
+	batchV1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	networkingV1 "k8s.io/api/networking/v1"
+	nodeV1 "k8s.io/api/node/v1"
+	policyV1 "k8s.io/api/policy/v1"
+	schedulingV1 "k8s.io/api/scheduling/v1"
+	storageV1 "k8s.io/api/storage/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// column returns a table column definition, columns with a priority above 0 are only shown in wide output
+func column(name, columnType string, priority int32) metaV1.TableColumnDefinition {
+	return metaV1.TableColumnDefinition{Name: name, Type: columnType, Priority: priority}
+}
+
+// printers holds the printer of each kind in the cache, equivalent to the kubectl get columns
+// Custom resources are printed with the additionalPrinterColumns of their CRD instead
+var printers = map[schema.GroupKind]printer{
+	{Kind: "Pod"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Ready", "string", 0), column("Status", "string", 0), column("Restarts", "integer", 0), ageColumn,
+		column("IP", "string", 1), column("Node", "string", 1),
+	}, podCells),
+
+	{Group: "apps", Kind: "Deployment"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Ready", "string", 0), column("Up-to-date", "integer", 0), column("Available", "integer", 0), ageColumn,
+		column("Containers", "string", 1), column("Images", "string", 1), column("Selector", "string", 1),
+	}, func(d *appsV1.Deployment, now time.Time) []interface{} {
+		names, images := containerNamesAndImages(d.Spec.Template.Spec.Containers)
+		return []interface{}{
+			fmt.Sprintf("%d/%d", d.Status.ReadyReplicas, replicas(d.Spec.Replicas)), int64(d.Status.UpdatedReplicas), int64(d.Status.AvailableReplicas),
+			age(d.CreationTimestamp, now), names, images, metaV1.FormatLabelSelector(d.Spec.Selector),
+		}
+	}),
+
+	{Group: "apps", Kind: "DaemonSet"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Desired", "integer", 0), column("Current", "integer", 0), column("Ready", "integer", 0), column("Up-to-date", "integer", 0),
+		column("Available", "integer", 0), column("Node Selector", "string", 0), ageColumn,
+		column("Containers", "string", 1), column("Images", "string", 1), column("Selector", "string", 1),
+	}, func(d *appsV1.DaemonSet, now time.Time) []interface{} {
+		names, images := containerNamesAndImages(d.Spec.Template.Spec.Containers)
+		return []interface{}{
+			int64(d.Status.DesiredNumberScheduled), int64(d.Status.CurrentNumberScheduled), int64(d.Status.NumberReady),
+			int64(d.Status.UpdatedNumberScheduled), int64(d.Status.NumberAvailable), labels.FormatLabels(d.Spec.Template.Spec.NodeSelector),
+			age(d.CreationTimestamp, now), names, images, metaV1.FormatLabelSelector(d.Spec.Selector),
+		}
+	}),
+
+	{Group: "apps", Kind: "StatefulSet"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Ready", "string", 0), ageColumn, column("Containers", "string", 1), column("Images", "string", 1),
+	}, func(s *appsV1.StatefulSet, now time.Time) []interface{} {
+		names, images := containerNamesAndImages(s.Spec.Template.Spec.Containers)
+		return []interface{}{fmt.Sprintf("%d/%d", s.Status.ReadyReplicas, replicas(s.Spec.Replicas)), age(s.CreationTimestamp, now), names, images}
+	}),
+
+	{Group: "batch", Kind: "Job"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Status", "string", 0), column("Completions", "string", 0), column("Duration", "string", 0), ageColumn,
+		column("Containers", "string", 1), column("Images", "string", 1), column("Selector", "string", 1),
+	}, jobCells),
+
+	{Group: "batch", Kind: "CronJob"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Schedule", "string", 0), column("Timezone", "string", 0), column("Suspend", "boolean", 0), column("Active", "integer", 0),
+		column("Last Schedule", "string", 0), ageColumn, column("Containers", "string", 1), column("Images", "string", 1),
+	}, func(c *batchV1.CronJob, now time.Time) []interface{} {
+		names, images := containerNamesAndImages(c.Spec.JobTemplate.Spec.Template.Spec.Containers)
+		lastSchedule := "<none>"
+		if c.Status.LastScheduleTime != nil {
+			lastSchedule = age(*c.Status.LastScheduleTime, now)
+		}
+		timeZone := "<none>"
+		if c.Spec.TimeZone != nil {
+			timeZone = *c.Spec.TimeZone
+		}
+		suspend := c.Spec.Suspend != nil && *c.Spec.Suspend
+		return []interface{}{c.Spec.Schedule, timeZone, suspend, int64(len(c.Status.Active)), lastSchedule, age(c.CreationTimestamp, now), names, images}
+	}),
+
+	{Kind: "Node"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Status", "string", 0), column("Roles", "string", 0), ageColumn, column("Version", "string", 0),
+		column("Internal-IP", "string", 1), column("OS-Image", "string", 1), column("Kernel-Version", "string", 1), column("Container-Runtime", "string", 1),
+	}, nodeCells),
+
+	{Kind: "Namespace"}: typedPrinter([]metaV1.TableColumnDefinition{column("Status", "string", 0), ageColumn},
+		func(n *coreV1.Namespace, now time.Time) []interface{} {
+			return []interface{}{string(n.Status.Phase), age(n.CreationTimestamp, now)}
+		}),
+
+	{Kind: "Event"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Last Seen", "string", 0), column("Type", "string", 0), column("Reason", "string", 0), column("Object", "string", 0),
+		column("Message", "string", 0), column("Source", "string", 1), column("Count", "integer", 1),
+	}, eventCells),
+
+	{Kind: "ConfigMap"}: typedPrinter([]metaV1.TableColumnDefinition{column("Data", "integer", 0), ageColumn},
+		func(c *coreV1.ConfigMap, now time.Time) []interface{} {
+			return []interface{}{int64(len(c.Data) + len(c.BinaryData)), age(c.CreationTimestamp, now)}
+		}),
+
+	{Kind: "Secret"}: typedPrinter([]metaV1.TableColumnDefinition{column("Type", "string", 0), column("Data", "integer", 0), ageColumn},
+		func(s *coreV1.Secret, now time.Time) []interface{} {
+			return []interface{}{string(s.Type), int64(len(s.Data)), age(s.CreationTimestamp, now)}
+		}),
+
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}: typedPrinter(
+		[]metaV1.TableColumnDefinition{column("Webhooks", "integer", 0), ageColumn},
+		func(m *admissionRegV1.MutatingWebhookConfiguration, now time.Time) []interface{} {
+			return []interface{}{int64(len(m.Webhooks)), age(m.CreationTimestamp, now)}
+		}),
+
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}: typedPrinter(
+		[]metaV1.TableColumnDefinition{column("Webhooks", "integer", 0), ageColumn},
+		func(v *admissionRegV1.ValidatingWebhookConfiguration, now time.Time) []interface{} {
+			return []interface{}{int64(len(v.Webhooks)), age(v.CreationTimestamp, now)}
+		}),
+
+	{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Reference", "string", 0), column("Targets", "string", 0), column("MinPods", "integer", 0), column("MaxPods", "integer", 0),
+		column("Replicas", "integer", 0), ageColumn,
+	}, func(h *autoScalingV2.HorizontalPodAutoscaler, now time.Time) []interface{} {
+		return []interface{}{
+			fmt.Sprintf("%s/%s", h.Spec.ScaleTargetRef.Kind, h.Spec.ScaleTargetRef.Name), hpaTargets(h), int64(replicas(h.Spec.MinReplicas)),
+			int64(h.Spec.MaxReplicas), int64(h.Status.CurrentReplicas), age(h.CreationTimestamp, now),
+		}
+	}),
+
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Value", "integer", 0), column("Global-Default", "boolean", 0), ageColumn, column("Preemption-Policy", "string", 1),
+	}, func(p *schedulingV1.PriorityClass, now time.Time) []interface{} {
+		preemptionPolicy := string(coreV1.PreemptLowerPriority)
+		if p.PreemptionPolicy != nil {
+			preemptionPolicy = string(*p.PreemptionPolicy)
+		}
+		return []interface{}{int64(p.Value), p.GlobalDefault, age(p.CreationTimestamp, now), preemptionPolicy}
+	}),
+
+	{Group: "node.k8s.io", Kind: "RuntimeClass"}: typedPrinter([]metaV1.TableColumnDefinition{column("Handler", "string", 0), ageColumn},
+		func(r *nodeV1.RuntimeClass, now time.Time) []interface{} {
+			return []interface{}{r.Handler, age(r.CreationTimestamp, now)}
+		}),
+
+	{Group: "policy", Kind: "PodDisruptionBudget"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Min Available", "string", 0), column("Max Unavailable", "string", 0), column("Allowed Disruptions", "integer", 0), ageColumn,
+	}, func(p *policyV1.PodDisruptionBudget, now time.Time) []interface{} {
+		minAvailable, maxUnavailable := "N/A", "N/A"
+		if p.Spec.MinAvailable != nil {
+			minAvailable = p.Spec.MinAvailable.String()
+		}
+		if p.Spec.MaxUnavailable != nil {
+			maxUnavailable = p.Spec.MaxUnavailable.String()
+		}
+		return []interface{}{minAvailable, maxUnavailable, int64(p.Status.DisruptionsAllowed), age(p.CreationTimestamp, now)}
+	}),
+
+	{Kind: "LimitRange"}: typedPrinter([]metaV1.TableColumnDefinition{ageColumn},
+		func(l *coreV1.LimitRange, now time.Time) []interface{} {
+			return []interface{}{age(l.CreationTimestamp, now)}
+		}),
+
+	{Kind: "ResourceQuota"}: typedPrinter([]metaV1.TableColumnDefinition{ageColumn, column("Request", "string", 0), column("Limit", "string", 0)},
+		resourceQuotaCells),
+
+	{Kind: "Service"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Type", "string", 0), column("Cluster-IP", "string", 0), column("External-IP", "string", 0), column("Port(s)", "string", 0),
+		ageColumn, column("Selector", "string", 1),
+	}, func(s *coreV1.Service, now time.Time) []interface{} {
+		return []interface{}{
+			string(s.Spec.Type), orNone(s.Spec.ClusterIP), serviceExternalIP(s), servicePorts(s.Spec.Ports), age(s.CreationTimestamp, now),
+			labels.FormatLabels(s.Spec.Selector),
+		}
+	}),
+
+	{Group: "networking.k8s.io", Kind: "NetworkPolicy"}: typedPrinter([]metaV1.TableColumnDefinition{column("Pod-Selector", "string", 0), ageColumn},
+		func(n *networkingV1.NetworkPolicy, now time.Time) []interface{} {
+			return []interface{}{metaV1.FormatLabelSelector(&n.Spec.PodSelector), age(n.CreationTimestamp, now)}
+		}),
+
+	{Kind: "Endpoints"}: typedPrinter([]metaV1.TableColumnDefinition{column("Endpoints", "string", 0), ageColumn},
+		func(e *coreV1.Endpoints, now time.Time) []interface{} {
+			return []interface{}{endpointAddresses(e.Subsets), age(e.CreationTimestamp, now)}
+		}),
+
+	{Kind: "PersistentVolume"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Capacity", "string", 0), column("Access Modes", "string", 0), column("Reclaim Policy", "string", 0), column("Status", "string", 0),
+		column("Claim", "string", 0), column("StorageClass", "string", 0), column("Reason", "string", 0), ageColumn, column("VolumeMode", "string", 1),
+	}, func(p *coreV1.PersistentVolume, now time.Time) []interface{} {
+		claim := ""
+		if p.Spec.ClaimRef != nil {
+			claim = fmt.Sprintf("%s/%s", p.Spec.ClaimRef.Namespace, p.Spec.ClaimRef.Name)
+		}
+		capacity := p.Spec.Capacity[coreV1.ResourceStorage]
+		return []interface{}{
+			capacity.String(), accessModes(p.Spec.AccessModes), string(p.Spec.PersistentVolumeReclaimPolicy), string(p.Status.Phase),
+			claim, p.Spec.StorageClassName, p.Status.Reason, age(p.CreationTimestamp, now), volumeMode(p.Spec.VolumeMode),
+		}
+	}),
+
+	{Kind: "PersistentVolumeClaim"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Status", "string", 0), column("Volume", "string", 0), column("Capacity", "string", 0), column("Access Modes", "string", 0),
+		column("StorageClass", "string", 0), ageColumn, column("VolumeMode", "string", 1),
+	}, func(p *coreV1.PersistentVolumeClaim, now time.Time) []interface{} {
+		capacity, modes, storageClass := "", "", ""
+		if p.Spec.VolumeName != "" {
+			quantity := p.Status.Capacity[coreV1.ResourceStorage]
+			capacity, modes = quantity.String(), accessModes(p.Status.AccessModes)
+		}
+		if p.Spec.StorageClassName != nil {
+			storageClass = *p.Spec.StorageClassName
+		}
+		return []interface{}{
+			string(p.Status.Phase), p.Spec.VolumeName, capacity, modes, storageClass, age(p.CreationTimestamp, now), volumeMode(p.Spec.VolumeMode),
+		}
+	}),
+
+	{Group: "storage.k8s.io", Kind: "StorageClass"}: typedPrinter([]metaV1.TableColumnDefinition{
+		column("Provisioner", "string", 0), column("ReclaimPolicy", "string", 0), column("VolumeBindingMode", "string", 0),
+		column("AllowVolumeExpansion", "boolean", 0), ageColumn,
+	}, func(s *storageV1.StorageClass, now time.Time) []interface{} {
+		reclaimPolicy := string(coreV1.PersistentVolumeReclaimDelete)
+		if s.ReclaimPolicy != nil {
+			reclaimPolicy = string(*s.ReclaimPolicy)
+		}
+		bindingMode := string(storageV1.VolumeBindingImmediate)
+		if s.VolumeBindingMode != nil {
+			bindingMode = string(*s.VolumeBindingMode)
+		}
+		allowExpansion := s.AllowVolumeExpansion != nil && *s.AllowVolumeExpansion
+		return []interface{}{s.Provisioner, reclaimPolicy, bindingMode, allowExpansion, age(s.CreationTimestamp, now)}
+	}),
+
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: {
+		columns: []metaV1.TableColumnDefinition{{Name: "Created At", Type: "date"}, ageColumn},
+		cells: func(obj *unstructured.Unstructured, now time.Time) ([]interface{}, error) {
+			created := obj.GetCreationTimestamp()
+			return []interface{}{created.UTC().Format(time.RFC3339), age(created, now)}, nil
+		},
+	},
+}
+
+// podCells computes the pod columns, the status is derived from the container states like kubectl does
+func podCells(pod *coreV1.Pod, now time.Time) []interface{} {
+	restarts := int64(0)
+	readyContainers := 0
+	reason := string(pod.Status.Phase)
+	if pod.Status.Reason != "" {
+		reason = pod.Status.Reason
+	}
+
+	// Init containers that have not completed determine the status, sidecars that have started do not block
+	sidecars := map[string]bool{}
+	for _, container := range pod.Spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == coreV1.ContainerRestartPolicyAlways {
+			sidecars[container.Name] = true
+		}
+	}
+	initializing := false
+	for i, container := range pod.Status.InitContainerStatuses {
+		restarts += int64(container.RestartCount)
+		switch {
+		case container.State.Terminated != nil && container.State.Terminated.ExitCode == 0:
+			continue
+		case sidecars[container.Name] && container.Started != nil && *container.Started:
+			continue
+		case container.State.Terminated != nil && container.State.Terminated.Reason != "":
+			reason = "Init:" + container.State.Terminated.Reason
+		case container.State.Terminated != nil && container.State.Terminated.Signal != 0:
+			reason = fmt.Sprintf("Init:Signal:%d", container.State.Terminated.Signal)
+		case container.State.Terminated != nil:
+			reason = fmt.Sprintf("Init:ExitCode:%d", container.State.Terminated.ExitCode)
+		case container.State.Waiting != nil && container.State.Waiting.Reason != "" && container.State.Waiting.Reason != "PodInitializing":
+			reason = "Init:" + container.State.Waiting.Reason
+		default:
+			reason = fmt.Sprintf("Init:%d/%d", i, len(pod.Spec.InitContainers))
+		}
+		initializing = true
+		break
+	}
+
+	if !initializing {
+		hasRunning := false
+		for i := len(pod.Status.ContainerStatuses) - 1; i >= 0; i-- {
+			container := pod.Status.ContainerStatuses[i]
+			restarts += int64(container.RestartCount)
+			switch {
+			case container.State.Waiting != nil && container.State.Waiting.Reason != "":
+				reason = container.State.Waiting.Reason
+			case container.State.Terminated != nil && container.State.Terminated.Reason != "":
+				reason = container.State.Terminated.Reason
+			case container.State.Terminated != nil && container.State.Terminated.Signal != 0:
+				reason = fmt.Sprintf("Signal:%d", container.State.Terminated.Signal)
+			case container.State.Terminated != nil:
+				reason = fmt.Sprintf("ExitCode:%d", container.State.Terminated.ExitCode)
+			case container.Ready && container.State.Running != nil:
+				hasRunning = true
+				readyContainers++
+			}
+		}
+
+		// A container that completed while others are still running does not make the pod completed
+		if reason == "Completed" && hasRunning {
+			reason = "NotReady"
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == coreV1.PodReady && condition.Status == coreV1.ConditionTrue {
+					reason = "Running"
+				}
+			}
+		}
+	}
+
+	if pod.DeletionTimestamp != nil {
+		reason = "Terminating"
+		if pod.Status.Reason == "NodeLost" {
+			reason = "Unknown"
+		}
+	}
+
+	return []interface{}{
+		fmt.Sprintf("%d/%d", readyContainers, len(pod.Spec.Containers)), reason, restarts, age(pod.CreationTimestamp, now),
+		orNone(pod.Status.PodIP), orNone(pod.Spec.NodeName),
+	}
+}
+
+// jobCells computes the job columns
+func jobCells(job *batchV1.Job, now time.Time) []interface{} {
+	status := "Running"
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != coreV1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchV1.JobComplete:
+			status = "Complete"
+		case batchV1.JobFailed:
+			status = "Failed"
+		case batchV1.JobSuspended:
+			status = "Suspended"
+		}
+	}
+
+	var completions string
+	switch {
+	case job.Spec.Completions != nil:
+		completions = fmt.Sprintf("%d/%d", job.Status.Succeeded, *job.Spec.Completions)
+	case job.Spec.Parallelism != nil && *job.Spec.Parallelism > 1:
+		completions = fmt.Sprintf("%d/1 of %d", job.Status.Succeeded, *job.Spec.Parallelism)
+	default:
+		completions = fmt.Sprintf("%d/1", job.Status.Succeeded)
+	}
+
+	var jobDuration string
+	switch {
+	case job.Status.StartTime == nil:
+	case job.Status.CompletionTime == nil:
+		jobDuration = duration.HumanDuration(now.Sub(job.Status.StartTime.Time))
+	default:
+		jobDuration = duration.HumanDuration(job.Status.CompletionTime.Sub(job.Status.StartTime.Time))
+	}
+
+	names, images := containerNamesAndImages(job.Spec.Template.Spec.Containers)
+	return []interface{}{status, completions, jobDuration, age(job.CreationTimestamp, now), names, images, metaV1.FormatLabelSelector(job.Spec.Selector)}
+}
+
+// nodeCells computes the node columns
+func nodeCells(node *coreV1.Node, now time.Time) []interface{} {
+	status := "Unknown"
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != coreV1.NodeReady {
+			continue
+		}
+		switch condition.Status {
+		case coreV1.ConditionTrue:
+			status = "Ready"
+		case coreV1.ConditionFalse:
+			status = "NotReady"
+		}
+	}
+	if node.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+
+	var roles []string
+	for label, value := range node.Labels {
+		switch {
+		case strings.HasPrefix(label, "node-role.kubernetes.io/"):
+			if role := strings.TrimPrefix(label, "node-role.kubernetes.io/"); role != "" {
+				roles = append(roles, role)
+			}
+		case label == "kubernetes.io/role" && value != "":
+			roles = append(roles, value)
+		}
+	}
+	sort.Strings(roles)
+
+	internalIP := "<none>"
+	for _, address := range node.Status.Addresses {
+		if address.Type == coreV1.NodeInternalIP {
+			internalIP = address.Address
+			break
+		}
+	}
+
+	info := node.Status.NodeInfo
+	return []interface{}{
+		status, joinOrNone(roles), age(node.CreationTimestamp, now), info.KubeletVersion,
+		internalIP, info.OSImage, info.KernelVersion, info.ContainerRuntimeVersion,
+	}
+}
+
+// eventCells computes the event columns
+func eventCells(event *coreV1.Event, now time.Time) []interface{} {
+	lastSeen := event.LastTimestamp
+	switch {
+	case event.Series != nil:
+		lastSeen = metaV1.Time(event.Series.LastObservedTime)
+	case lastSeen.IsZero() && !event.EventTime.IsZero():
+		lastSeen = metaV1.Time(event.EventTime)
+	case lastSeen.IsZero():
+		lastSeen = event.FirstTimestamp
+	}
+
+	source := event.Source.Component
+	if source == "" {
+		source = event.ReportingController
+	}
+	if event.Source.Host != "" {
+		source = fmt.Sprintf("%s, %s", source, event.Source.Host)
+	}
+
+	count := int64(event.Count)
+	if event.Series != nil {
+		count = int64(event.Series.Count)
+	}
+
+	object := fmt.Sprintf("%s/%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name)
+	return []interface{}{age(lastSeen, now), event.Type, event.Reason, object, strings.TrimSpace(event.Message), source, count}
+}
+
+// resourceQuotaCells computes the resource quota columns, listing the used and hard amount of each resource
+func resourceQuotaCells(quota *coreV1.ResourceQuota, now time.Time) []interface{} {
+	names := make([]string, 0, len(quota.Spec.Hard))
+	for name := range quota.Spec.Hard {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	var requests, limits []string
+	for _, name := range names {
+		hard := quota.Spec.Hard[coreV1.ResourceName(name)]
+		used := quota.Status.Used[coreV1.ResourceName(name)]
+		usage := fmt.Sprintf("%s: %s/%s", name, used.String(), hard.String())
+		if strings.HasPrefix(name, "limits.") {
+			limits = append(limits, usage)
+		} else {
+			requests = append(requests, usage)
+		}
+	}
+
+	return []interface{}{age(quota.CreationTimestamp, now), strings.Join(requests, ", "), strings.Join(limits, ", ")}
+}
+
+// hpaTargets formats the current and target value of each metric of the autoscaler, e.g. cpu: 35%/80%
+func hpaTargets(hpa *autoScalingV2.HorizontalPodAutoscaler) string {
+	var targets []string
+	for _, metric := range hpa.Spec.Metrics {
+		if metric.Type != autoScalingV2.ResourceMetricSourceType || metric.Resource == nil {
+			targets = append(targets, fmt.Sprintf("%s: <unknown>", metric.Type))
+			continue
+		}
+
+		var current *autoScalingV2.MetricValueStatus
+		for _, status := range hpa.Status.CurrentMetrics {
+			if status.Type == autoScalingV2.ResourceMetricSourceType && status.Resource != nil && status.Resource.Name == metric.Resource.Name {
+				current = &status.Resource.Current
+			}
+		}
+
+		target := metric.Resource.Target
+		value := "<unknown>"
+		switch {
+		case target.AverageUtilization != nil:
+			if current != nil && current.AverageUtilization != nil {
+				value = fmt.Sprintf("%d%%", *current.AverageUtilization)
+			}
+			value = fmt.Sprintf("%s/%d%%", value, *target.AverageUtilization)
+		case target.AverageValue != nil:
+			if current != nil && current.AverageValue != nil {
+				value = current.AverageValue.String()
+			}
+			value = fmt.Sprintf("%s/%s", value, target.AverageValue.String())
+		}
+		targets = append(targets, fmt.Sprintf("%s: %s", metric.Resource.Name, value))
+	}
+
+	if len(targets) == 0 {
+		return "<none>"
+	}
+	return strings.Join(targets, ", ")
+}
+
+// serviceExternalIP returns the external addresses of the service
+func serviceExternalIP(service *coreV1.Service) string {
+	switch service.Spec.Type {
+	case coreV1.ServiceTypeExternalName:
+		return service.Spec.ExternalName
+	case coreV1.ServiceTypeLoadBalancer:
+		addresses := append([]string{}, service.Spec.ExternalIPs...)
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				addresses = append(addresses, ingress.IP)
+			} else if ingress.Hostname != "" {
+				addresses = append(addresses, ingress.Hostname)
+			}
+		}
+		if len(addresses) == 0 {
+			return "<pending>"
+		}
+		return strings.Join(addresses, ",")
+	default:
+		return joinOrNone(service.Spec.ExternalIPs)
+	}
+}
+
+// servicePorts formats the ports of a service, e.g. 80:30080/TCP
+func servicePorts(ports []coreV1.ServicePort) string {
+	formatted := make([]string, 0, len(ports))
+	for _, port := range ports {
+		if port.NodePort > 0 {
+			formatted = append(formatted, fmt.Sprintf("%d:%d/%s", port.Port, port.NodePort, port.Protocol))
+		} else {
+			formatted = append(formatted, fmt.Sprintf("%d/%s", port.Port, port.Protocol))
+		}
+	}
+	return joinOrNone(formatted)
+}
+
+// endpointAddresses formats up to three addresses of the endpoints, e.g. 10.0.0.1:80,10.0.0.2:80 + 1 more...
+func endpointAddresses(subsets []coreV1.EndpointSubset) string {
+	const shown = 3
+
+	var addresses []string
+	total := 0
+	for _, subset := range subsets {
+		for _, address := range subset.Addresses {
+			if len(subset.Ports) == 0 {
+				total++
+				if len(addresses) < shown {
+					addresses = append(addresses, address.IP)
+				}
+				continue
+			}
+			for _, port := range subset.Ports {
+				total++
+				if len(addresses) < shown {
+					addresses = append(addresses, fmt.Sprintf("%s:%d", address.IP, port.Port))
+				}
+			}
+		}
+	}
+
+	formatted := joinOrNone(addresses)
+	if total > shown {
+		formatted += fmt.Sprintf(" + %d more...", total-shown)
+	}
+	return formatted
+}
+
+// accessModes formats the access modes of a volume in their short form, e.g. RWO,ROX
+func accessModes(modes []coreV1.PersistentVolumeAccessMode) string {
+	short := map[coreV1.PersistentVolumeAccessMode]string{
+		coreV1.ReadWriteOnce:    "RWO",
+		coreV1.ReadOnlyMany:     "ROX",
+		coreV1.ReadWriteMany:    "RWX",
+		coreV1.ReadWriteOncePod: "RWOP",
+	}
+
+	var formatted []string
+	for _, mode := range modes {
+		if name, found := short[mode]; found && !slices.Contains(formatted, name) {
+			formatted = append(formatted, name)
+		}
+	}
+	return strings.Join(formatted, ",")
+}
+
+// volumeMode returns the volume mode, volumes default to Filesystem
+func volumeMode(mode *coreV1.PersistentVolumeMode) string {
+	if mode == nil {
+		return string(coreV1.PersistentVolumeFilesystem)
+	}
+	return string(*mode)
+}
+
+// containerNamesAndImages returns the names and images of the containers, joined with commas
+func containerNamesAndImages(containers []coreV1.Container) (string, string) {
+	names := make([]string, 0, len(containers))
+	images := make([]string, 0, len(containers))
+	for _, container := range containers {
+		names = append(names, container.Name)
+		images = append(images, container.Image)
+	}
+	return strings.Join(names, ","), strings.Join(images, ",")
+}
+
+// replicas returns the desired number of replicas, which defaults to 1
+func replicas(count *int32) int32 {
+	if count == nil {
+		return 1
+	}
+	return *count
+}
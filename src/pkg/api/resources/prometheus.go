@@ -0,0 +1,296 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// PrometheusSource collects metrics from the cAdvisor metrics scraped by Prometheus
+// CPU usage is averaged over the rate window, memory usage is the working set like metrics-server reports
+type PrometheusSource struct {
+	client *http.Client
+	url    string
+	window string
+}
+
+// NewPrometheusSource creates a metrics source querying the Prometheus HTTP API at url
+func NewPrometheusSource(url string) *PrometheusSource {
+	return &PrometheusSource{
+		client: &http.Client{Timeout: 10 * time.Second},
+		url:    strings.TrimSuffix(url, "/"),
+		window: "5m",
+	}
+}
+
+// containerSelector selects the cAdvisor series of containers in the watched namespaces
+func containerSelector() string {
+	selector := `container!="",container!="POD"`
+	if len(config.WatchNamespaces) > 0 {
+		namespaces := make([]string, len(config.WatchNamespaces))
+		for i, namespace := range config.WatchNamespaces {
+			namespaces[i] = regexp.QuoteMeta(namespace)
+		}
+		selector += fmt.Sprintf(`,namespace=~"%s"`, strings.Join(namespaces, "|"))
+	}
+	return selector
+}
+
+// PodMetrics returns the CPU and memory usage of the containers of all pods in the watched namespaces
+func (p *PrometheusSource) PodMetrics(ctx context.Context) ([]v1beta1.PodMetrics, error) {
+	selector := containerSelector()
+	cpu, err := p.query(ctx, fmt.Sprintf(`sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{%s}[%s]))`, selector, p.window))
+	if err != nil {
+		return nil, err
+	}
+	memory, err := p.query(ctx, fmt.Sprintf(`sum by (namespace, pod, container) (container_memory_working_set_bytes{%s})`, selector))
+	if err != nil {
+		return nil, err
+	}
+
+	type containerKey struct{ namespace, pod, container string }
+	usage := make(map[containerKey]coreV1.ResourceList)
+	var order []containerKey
+	add := func(samples []prometheusSample, name coreV1.ResourceName, quantity func(float64) resource.Quantity) {
+		for _, sample := range samples {
+			key := containerKey{sample.Metric["namespace"], sample.Metric["pod"], sample.Metric["container"]}
+			if _, found := usage[key]; !found {
+				usage[key] = coreV1.ResourceList{}
+				order = append(order, key)
+			}
+			usage[key][name] = quantity(sample.Value)
+		}
+	}
+	add(cpu, coreV1.ResourceCPU, cpuQuantity)
+	add(memory, coreV1.ResourceMemory, memoryQuantity)
+
+	// Group the containers by pod
+	pods := make(map[string]int)
+	var result []v1beta1.PodMetrics
+	for _, key := range order {
+		podKey := key.namespace + "/" + key.pod
+		i, found := pods[podKey]
+		if !found {
+			i = len(result)
+			pods[podKey] = i
+			result = append(result, v1beta1.PodMetrics{
+				TypeMeta:   metaV1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "PodMetrics"},
+				ObjectMeta: metaV1.ObjectMeta{Namespace: key.namespace, Name: key.pod},
+				Window:     metaV1.Duration{Duration: 5 * time.Minute},
+			})
+		}
+		result[i].Containers = append(result[i].Containers, v1beta1.ContainerMetrics{Name: key.container, Usage: usage[key]})
+	}
+	return result, nil
+}
+
+// NodeMetrics returns the CPU and memory usage of all nodes from their root cgroup
+// Node metrics are cluster-scoped, so they are not collected when the cache is limited to some namespaces
+func (p *PrometheusSource) NodeMetrics(ctx context.Context) ([]v1beta1.NodeMetrics, error) {
+	if len(config.WatchNamespaces) > 0 {
+		return nil, nil
+	}
+
+	cpu, err := p.query(ctx, fmt.Sprintf(`sum by (node) (rate(container_cpu_usage_seconds_total{id="/"}[%s]))`, p.window))
+	if err != nil {
+		return nil, err
+	}
+	memory, err := p.query(ctx, `sum by (node) (container_memory_working_set_bytes{id="/"})`)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]int)
+	var result []v1beta1.NodeMetrics
+	add := func(samples []prometheusSample, name coreV1.ResourceName, quantity func(float64) resource.Quantity) {
+		for _, sample := range samples {
+			node := sample.Metric["node"]
+			i, found := nodes[node]
+			if !found {
+				i = len(result)
+				nodes[node] = i
+				result = append(result, v1beta1.NodeMetrics{
+					TypeMeta:   metaV1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "NodeMetrics"},
+					ObjectMeta: metaV1.ObjectMeta{Name: node},
+					Window:     metaV1.Duration{Duration: 5 * time.Minute},
+					Usage:      coreV1.ResourceList{},
+				})
+			}
+			result[i].Usage[name] = quantity(sample.Value)
+		}
+	}
+	add(cpu, coreV1.ResourceCPU, cpuQuantity)
+	add(memory, coreV1.ResourceMemory, memoryQuantity)
+	return result, nil
+}
+
+// UsageRange returns the total CPU and memory usage of the containers in the watched namespaces between from and to
+func (p *PrometheusSource) UsageRange(ctx context.Context, from, to time.Time, step time.Duration) ([]Usage, error) {
+	selector := containerSelector()
+	cpu, err := p.queryRange(ctx, fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{%s}[%s])) * 1000`, selector, p.window), from, to, step)
+	if err != nil {
+		return nil, err
+	}
+	memory, err := p.queryRange(ctx, fmt.Sprintf(`sum(container_memory_working_set_bytes{%s})`, selector), from, to, step)
+	if err != nil {
+		return nil, err
+	}
+
+	// Join the CPU and memory samples by timestamp, a step missing either is skipped
+	memoryAt := make(map[int64]float64, len(memory))
+	for _, sample := range memory {
+		memoryAt[sample.Timestamp.UnixMilli()] = sample.Value
+	}
+	usage := make([]Usage, 0, len(cpu))
+	for _, sample := range cpu {
+		if mem, found := memoryAt[sample.Timestamp.UnixMilli()]; found {
+			usage = append(usage, Usage{Timestamp: sample.Timestamp, CPU: sample.Value, Memory: mem})
+		}
+	}
+	return usage, nil
+}
+
+// cpuQuantity converts cores to a quantity in millicores
+func cpuQuantity(cores float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(cores*1000), resource.DecimalSI)
+}
+
+// memoryQuantity converts bytes to a quantity
+func memoryQuantity(bytes float64) resource.Quantity {
+	return *resource.NewQuantity(int64(bytes), resource.BinarySI)
+}
+
+// prometheusSample is a sample of an instant vector
+type prometheusSample struct {
+	Metric    map[string]string
+	Timestamp time.Time
+	Value     float64
+}
+
+// prometheusResponse is the envelope of Prometheus HTTP API responses
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+			Values [][]interface{}   `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// query evaluates an instant query that must return a vector
+func (p *PrometheusSource) query(ctx context.Context, query string) ([]prometheusSample, error) {
+	response, err := p.get(ctx, "/api/v1/query", url.Values{"query": {query}})
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("unexpected prometheus result type %q", response.Data.ResultType)
+	}
+
+	samples := make([]prometheusSample, 0, len(response.Data.Result))
+	for _, result := range response.Data.Result {
+		timestamp, value, err := parsePrometheusValue(result.Value)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, prometheusSample{Metric: result.Metric, Timestamp: timestamp, Value: value})
+	}
+	return samples, nil
+}
+
+// queryRange evaluates a range query that must return a single series
+func (p *PrometheusSource) queryRange(ctx context.Context, query string, from, to time.Time, step time.Duration) ([]prometheusSample, error) {
+	response, err := p.get(ctx, "/api/v1/query_range", url.Values{
+		"query": {query},
+		"start": {strconv.FormatInt(from.Unix(), 10)},
+		"end":   {strconv.FormatInt(to.Unix(), 10)},
+		"step":  {strconv.FormatFloat(step.Seconds(), 'f', -1, 64)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("unexpected prometheus result type %q", response.Data.ResultType)
+	}
+	if len(response.Data.Result) == 0 {
+		return nil, nil
+	}
+
+	values := response.Data.Result[0].Values
+	samples := make([]prometheusSample, 0, len(values))
+	for _, pair := range values {
+		timestamp, value, err := parsePrometheusValue(pair)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, prometheusSample{Timestamp: timestamp, Value: value})
+	}
+	return samples, nil
+}
+
+// get calls the Prometheus HTTP API and decodes the response
+func (p *PrometheusSource) get(ctx context.Context, path string, params url.Values) (*prometheusResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var response prometheusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode prometheus response: %s", resp.Status)
+	}
+	if response.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", response.Error)
+	}
+	return &response, nil
+}
+
+// parsePrometheusValue parses a [<unix time>, "<value>"] pair
+func parsePrometheusValue(pair []interface{}) (time.Time, float64, error) {
+	if len(pair) != 2 {
+		return time.Time{}, 0, fmt.Errorf("invalid prometheus sample %v", pair)
+	}
+	seconds, ok := pair[0].(float64)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("invalid prometheus sample time %v", pair[0])
+	}
+	text, ok := pair[1].(string)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("invalid prometheus sample value %v", pair[1])
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid prometheus sample value %q", text)
+	}
+	// A rate over too few samples is NaN, treat it as no usage
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		value = 0
+	}
+	return time.UnixMilli(int64(seconds * 1000)), value, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// prometheusServer answers Prometheus API queries with canned results keyed by a substring of the query
+type prometheusServer struct {
+	*httptest.Server
+	mutex   sync.Mutex
+	results map[string]any
+	queries []string
+}
+
+func newPrometheusServer(t *testing.T, results map[string]any) *prometheusServer {
+	s := &prometheusServer{results: results}
+	handler := func(resultType string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query().Get("query")
+			s.mutex.Lock()
+			s.queries = append(s.queries, query)
+			s.mutex.Unlock()
+
+			for match, result := range s.results {
+				if strings.Contains(query, match) {
+					//nolint:errcheck
+					json.NewEncoder(w).Encode(map[string]any{
+						"status": "success",
+						"data":   map[string]any{"resultType": resultType, "result": result},
+					})
+					return
+				}
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			//nolint:errcheck
+			json.NewEncoder(w).Encode(map[string]any{"status": "error", "errorType": "bad_data", "error": "unexpected query"})
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/query", handler("vector"))
+	mux.HandleFunc("/api/v1/query_range", handler("matrix"))
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func vectorSample(value string, labels ...string) map[string]any {
+	metric := map[string]string{}
+	for i := 0; i < len(labels); i += 2 {
+		metric[labels[i]] = labels[i+1]
+	}
+	return map[string]any{"metric": metric, "value": []any{1727740800.5, value}}
+}
+
+func TestPrometheusPodMetrics(t *testing.T) {
+	server := newPrometheusServer(t, map[string]any{
+		"sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total": []any{
+			vectorSample("0.25", "namespace", "default", "pod", "app", "container", "app"),
+			vectorSample("0.05", "namespace", "default", "pod", "app", "container", "sidecar"),
+			vectorSample("NaN", "namespace", "kube-system", "pod", "dns", "container", "coredns"),
+		},
+		"sum by (namespace, pod, container) (container_memory_working_set_bytes": []any{
+			vectorSample("1048576", "namespace", "default", "pod", "app", "container", "app"),
+			vectorSample("2048", "namespace", "default", "pod", "app", "container", "sidecar"),
+			vectorSample("4096", "namespace", "kube-system", "pod", "dns", "container", "coredns"),
+		},
+	})
+	source := NewPrometheusSource(server.URL)
+
+	metrics, err := source.PodMetrics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, metrics, 2)
+
+	app := metrics[0]
+	require.Equal(t, "default", app.Namespace)
+	require.Equal(t, "app", app.Name)
+	require.Len(t, app.Containers, 2)
+	require.Equal(t, int64(250), app.Containers[0].Usage.Cpu().MilliValue())
+	require.Equal(t, int64(1048576), app.Containers[0].Usage.Memory().Value())
+
+	// A NaN rate is no usage
+	dns := metrics[1]
+	require.Equal(t, int64(0), dns.Containers[0].Usage.Cpu().MilliValue())
+	require.Equal(t, int64(4096), dns.Containers[0].Usage.Memory().Value())
+
+	// Usage is totalled per pod like metrics-server pod metrics
+	cpu, mem := (&Cache{}).CalculateUsage(&app)
+	require.Equal(t, float64(300), cpu)
+	require.Equal(t, float64(1048576+2048), mem)
+}
+
+func TestPrometheusPodMetricsNamespaced(t *testing.T) {
+	config.WatchNamespaces = []string{"team-a", "team.b"}
+	defer func() { config.WatchNamespaces = nil }()
+
+	server := newPrometheusServer(t, map[string]any{"container_": []any{}})
+	source := NewPrometheusSource(server.URL)
+
+	_, err := source.PodMetrics(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, server.queries[0], `namespace=~"team-a|team\.b"`)
+
+	// Node metrics are not collected when limited to some namespaces
+	nodes, err := source.NodeMetrics(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, nodes)
+}
+
+func TestPrometheusNodeMetrics(t *testing.T) {
+	server := newPrometheusServer(t, map[string]any{
+		"sum by (node) (rate(container_cpu_usage_seconds_total": []any{vectorSample("1.5", "node", "node-1")},
+		"sum by (node) (container_memory_working_set_bytes":     []any{vectorSample("2147483648", "node", "node-1")},
+	})
+
+	nodes, err := NewPrometheusSource(server.URL).NodeMetrics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, "node-1", nodes[0].Name)
+	require.Equal(t, int64(1500), nodes[0].Usage.Cpu().MilliValue())
+	require.Equal(t, int64(2<<30), nodes[0].Usage.Memory().Value())
+}
+
+func TestPrometheusUnavailable(t *testing.T) {
+	server := newPrometheusServer(t, map[string]any{})
+	source := NewPrometheusSource(server.URL)
+
+	_, err := source.PodMetrics(context.Background())
+	require.ErrorContains(t, err, "unexpected query")
+
+	server.Close()
+	_, err = source.PodMetrics(context.Background())
+	require.Error(t, err)
+}
+
+func TestPrometheusBackfill(t *testing.T) {
+	now := time.Now().Truncate(time.Minute)
+	series := func(values ...string) []any {
+		pairs := make([]any, len(values))
+		for i, value := range values {
+			timestamp := now.Add(time.Duration(i-len(values)) * 5 * time.Minute)
+			pairs[i] = []any{float64(timestamp.Unix()), value}
+		}
+		return []any{map[string]any{"metric": map[string]string{}, "values": pairs}}
+	}
+	server := newPrometheusServer(t, map[string]any{
+		"sum(rate(container_cpu_usage_seconds_total":  series("100", "200", "300"),
+		"sum(container_memory_working_set_bytes":      series("1024", "2048", "4096"),
+		"sum by (namespace, pod, container) (rate":    []any{},
+		"sum by (namespace, pod, container) (contain": []any{},
+		"sum by (node)": []any{},
+	})
+
+	pods := &ResourceList{
+		Resources:       make(map[string]*unstructured.Unstructured),
+		SparseResources: make(map[string]*unstructured.Unstructured),
+		gvk:             coreV1.SchemeGroupVersion.WithKind("Pod"),
+	}
+	cache := &Cache{
+		Pods:        pods,
+		Nodes:       &ResourceList{SparseResources: make(map[string]*unstructured.Unstructured)},
+		PodMetrics:  NewPodMetrics(),
+		NodeMetrics: NewNodeMetrics(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cache.StartMetricsCollection(ctx, NewPrometheusSource(server.URL))
+
+	// The backfilled history is followed by the first collection
+	history := cache.PodMetrics.GetHistoricalUsage()
+	require.Len(t, history, 4)
+	require.Equal(t, float64(100), history[0].CPU)
+	require.Equal(t, float64(4096), history[2].Memory)
+	require.True(t, history[2].Timestamp.Before(history[3].Timestamp))
+
+	// History is only backfilled when it is empty
+	cache.backfillHistory(ctx, NewPrometheusSource(server.URL))
+	require.Len(t, cache.PodMetrics.GetHistoricalUsage(), 4)
+}
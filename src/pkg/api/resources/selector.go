@@ -0,0 +1,105 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// Selector filters resources by label and field selectors with Kubernetes selector semantics
+type Selector struct {
+	labels labels.Selector
+	fields []fields.Requirement
+}
+
+// ParseSelector parses a label selector, e.g. app=foo,tier!=db, and a field selector, e.g. status.phase=Running
+// Field selectors can reference any path of the object, list items are referenced by index, e.g. spec.containers.0.name
+func ParseSelector(labelSelector, fieldSelector string) (Selector, error) {
+	var s Selector
+
+	if labelSelector != "" {
+		parsed, err := labels.Parse(labelSelector)
+		if err != nil {
+			return Selector{}, fmt.Errorf("invalid label selector: %w", err)
+		}
+		s.labels = parsed
+	}
+
+	if fieldSelector != "" {
+		parsed, err := fields.ParseSelector(fieldSelector)
+		if err != nil {
+			return Selector{}, fmt.Errorf("invalid field selector: %w", err)
+		}
+		s.fields = parsed.Requirements()
+	}
+
+	return s, nil
+}
+
+// Empty returns whether the selector matches everything
+func (s Selector) Empty() bool {
+	return (s.labels == nil || s.labels.Empty()) && len(s.fields) == 0
+}
+
+// Matches returns whether the resource matches the label and field selectors
+func (s Selector) Matches(resource *unstructured.Unstructured) bool {
+	if s.labels != nil && !s.labels.Matches(labels.Set(resource.GetLabels())) {
+		return false
+	}
+
+	for _, requirement := range s.fields {
+		// Missing fields compare as empty like in Kubernetes field selectors
+		value := fieldValue(resource.Object, requirement.Field)
+		switch requirement.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			if value != requirement.Value {
+				return false
+			}
+		case selection.NotEquals:
+			if value == requirement.Value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// fieldValue returns the value at the dot separated path as a string, or an empty string if it does not exist
+func fieldValue(object map[string]interface{}, path string) string {
+	var current interface{} = object
+	for _, key := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			current = node[key]
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return ""
+			}
+			current = node[index]
+		default:
+			return ""
+		}
+	}
+
+	switch value := current.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case bool, int64, float64:
+		return fmt.Sprint(value)
+	default:
+		// Objects and lists cannot be compared
+		return ""
+	}
+}
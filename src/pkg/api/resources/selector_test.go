@@ -0,0 +1,102 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func selectorTestPod(name string, labels map[string]interface{}, phase, nodeName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default", "uid": name, "labels": labels},
+		"spec": map[string]interface{}{
+			"nodeName":      nodeName,
+			"hostNetwork":   false,
+			"priority":      int64(100),
+			"containers":    []interface{}{map[string]interface{}{"name": "app", "image": "nginx"}},
+			"schedulerName": "default-scheduler",
+		},
+		"status": map[string]interface{}{"phase": phase},
+	}}
+}
+
+func TestSelector(t *testing.T) {
+	pod := selectorTestPod("web", map[string]interface{}{"app": "foo", "tier": "web"}, "Running", "node-1")
+
+	tests := []struct {
+		name          string
+		labelSelector string
+		fieldSelector string
+		matches       bool
+	}{
+		{name: "Empty selector", matches: true},
+		{name: "Label equals", labelSelector: "app=foo", matches: true},
+		{name: "Label not equals", labelSelector: "app=foo,tier!=db", matches: true},
+		{name: "Label mismatch", labelSelector: "app=bar", matches: false},
+		{name: "Label set based", labelSelector: "tier in (web,api),!canary", matches: true},
+		{name: "Label exists", labelSelector: "release", matches: false},
+		{name: "Field equals", fieldSelector: "status.phase=Running,spec.nodeName=node-1", matches: true},
+		{name: "Field double equals", fieldSelector: "status.phase==Running", matches: true},
+		{name: "Field not equals", fieldSelector: "status.phase!=Running", matches: false},
+		{name: "Field with leading dot", fieldSelector: ".metadata.name=web", matches: true},
+		{name: "Field in list", fieldSelector: "spec.containers.0.image=nginx", matches: true},
+		{name: "Field out of range", fieldSelector: "spec.containers.1.image=nginx", matches: false},
+		{name: "Boolean field", fieldSelector: "spec.hostNetwork=false", matches: true},
+		{name: "Integer field", fieldSelector: "spec.priority=100", matches: true},
+		{name: "Missing field is empty", fieldSelector: "spec.serviceAccountName!=default", matches: true},
+		{name: "Labels and fields", labelSelector: "app=foo", fieldSelector: "spec.nodeName=node-2", matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := ParseSelector(tt.labelSelector, tt.fieldSelector)
+			require.NoError(t, err)
+			require.Equal(t, tt.labelSelector == "" && tt.fieldSelector == "", selector.Empty())
+			require.Equal(t, tt.matches, selector.Matches(pod))
+		})
+	}
+}
+
+func TestParseSelectorInvalid(t *testing.T) {
+	_, err := ParseSelector("app==foo==bar", "")
+	require.ErrorContains(t, err, "invalid label selector")
+
+	_, err = ParseSelector("", "status.phase")
+	require.ErrorContains(t, err, "invalid field selector")
+}
+
+func TestGetSelectedResources(t *testing.T) {
+	r := &ResourceList{
+		Resources:       make(map[string]*unstructured.Unstructured),
+		SparseResources: make(map[string]*unstructured.Unstructured),
+	}
+	for _, pod := range []*unstructured.Unstructured{
+		selectorTestPod("web-1", map[string]interface{}{"app": "web"}, "Running", "node-1"),
+		selectorTestPod("web-2", map[string]interface{}{"app": "web"}, "Running", "node-2"),
+		selectorTestPod("db-1", map[string]interface{}{"app": "db"}, "Pending", "node-1"),
+	} {
+		r.Resources[pod.GetName()] = pod
+		r.SparseResources[pod.GetName()] = r.extractSparseObject(pod)
+	}
+
+	// spec.nodeName is not part of the sparse resources but can still be selected on
+	selector, err := ParseSelector("app=web", "spec.nodeName=node-1")
+	require.NoError(t, err)
+
+	sparse := r.GetSelectedResources("", "", selector, true)
+	require.Len(t, sparse, 1)
+	require.Equal(t, "web-1", sparse[0].GetName())
+	require.NotContains(t, sparse[0].Object, "spec")
+
+	dense := r.GetSelectedResources("default", "web", selector, false)
+	require.Len(t, dense, 1)
+	require.Contains(t, dense[0].Object, "spec")
+
+	require.Empty(t, r.GetSelectedResources("other", "", selector, false))
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// printer computes the table columns of a kind, like the printers of kubectl get
+type printer struct {
+	columns []metaV1.TableColumnDefinition
+	// cells returns the cells of a row, there is one per column after the name
+	cells func(obj *unstructured.Unstructured, now time.Time) ([]interface{}, error)
+}
+
+// nameColumn is the first column of every table
+var nameColumn = metaV1.TableColumnDefinition{Name: "Name", Type: "string", Format: "name", Description: "Name of the resource"}
+
+// ageColumn is the column for the time since the resource was created
+var ageColumn = metaV1.TableColumnDefinition{Name: "Age", Type: "string", Description: "Time since the resource was created"}
+
+// typedPrinter returns a printer that converts the resources to their typed form before computing the cells
+func typedPrinter[T any](columns []metaV1.TableColumnDefinition, cells func(obj *T, now time.Time) []interface{}) printer {
+	return printer{
+		columns: columns,
+		cells: func(obj *unstructured.Unstructured, now time.Time) ([]interface{}, error) {
+			var typed T
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &typed); err != nil {
+				return nil, err
+			}
+			return cells(&typed, now), nil
+		},
+	}
+}
+
+// Table returns the items as a metav1.Table, with the printer columns of the kind or, for custom resources,
+// the additionalPrinterColumns of their CRD
+func (r *ResourceList) Table(items []unstructured.Unstructured) *metaV1.Table {
+	return r.table(items, time.Now())
+}
+
+// table builds the table with ages relative to now
+func (r *ResourceList) table(items []unstructured.Unstructured, now time.Time) *metaV1.Table {
+	p := r.printer()
+
+	table := &metaV1.Table{
+		TypeMeta:          metaV1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "Table"},
+		ColumnDefinitions: append([]metaV1.TableColumnDefinition{nameColumn}, p.columns...),
+		Rows:              make([]metaV1.TableRow, 0, len(items)),
+	}
+
+	for i := range items {
+		item := &items[i]
+		cells, err := p.cells(item, now)
+		if err != nil {
+			// Keep the row so the table still lists every resource
+			cells = make([]interface{}, len(p.columns))
+		}
+
+		row := metaV1.TableRow{
+			Cells:  append([]interface{}{item.GetName()}, cells...),
+			Object: runtime.RawExtension{Raw: partialObjectMetadata(item)},
+		}
+		if completed(item) {
+			row.Conditions = []metaV1.TableRowCondition{{Type: metaV1.RowCompleted, Status: metaV1.ConditionTrue}}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table
+}
+
+// printer returns the printer for the kind of the list
+// Custom resources use the additionalPrinterColumns of their CRD, other kinds without a printer only have a name and age
+func (r *ResourceList) printer() printer {
+	if p, found := printers[r.gvk.GroupKind()]; found {
+		return p
+	}
+	if r.crds != nil {
+		if p, found := crdPrinter(r.GVR, r.crds); found {
+			return p
+		}
+	}
+	return printer{
+		columns: []metaV1.TableColumnDefinition{ageColumn},
+		cells: func(obj *unstructured.Unstructured, now time.Time) ([]interface{}, error) {
+			return []interface{}{age(obj.GetCreationTimestamp(), now)}, nil
+		},
+	}
+}
+
+// crdPrinter returns a printer for the additionalPrinterColumns of the served version of the custom resource
+// It is not found if the CRD does not exist or does not define columns for the version
+func crdPrinter(gvr schema.GroupVersionResource, crds *ResourceList) (printer, bool) {
+	crd, found := findCRD(gvr, crds)
+	if !found {
+		return printer{}, false
+	}
+
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		version, ok := v.(map[string]interface{})
+		if !ok || version["name"] != gvr.Version {
+			continue
+		}
+		definitions, _, _ := unstructured.NestedSlice(version, "additionalPrinterColumns")
+		if len(definitions) == 0 {
+			return printer{}, false
+		}
+		return newColumnPrinter(definitions), true
+	}
+
+	return printer{}, false
+}
+
+// findCRD returns the CRD of the custom resource
+func findCRD(gvr schema.GroupVersionResource, crds *ResourceList) (unstructured.Unstructured, bool) {
+	name := fmt.Sprintf("%s.%s", gvr.Resource, gvr.Group)
+	for _, crd := range crds.GetResources("", name) {
+		if crd.GetName() == name {
+			return crd, true
+		}
+	}
+	return unstructured.Unstructured{}, false
+}
+
+// printerColumn is a CRD additionalPrinterColumn with its parsed JSONPath
+type printerColumn struct {
+	definition metaV1.TableColumnDefinition
+	path       *jsonpath.JSONPath
+}
+
+// newColumnPrinter returns a printer for CRD additionalPrinterColumns, columns with an invalid JSONPath are skipped
+func newColumnPrinter(definitions []interface{}) printer {
+	var columns []printerColumn
+	for _, d := range definitions {
+		definition, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(definition, "name")
+		columnType, _, _ := unstructured.NestedString(definition, "type")
+		format, _, _ := unstructured.NestedString(definition, "format")
+		description, _, _ := unstructured.NestedString(definition, "description")
+		priority, _, _ := unstructured.NestedInt64(definition, "priority")
+		jsonPath, _, _ := unstructured.NestedString(definition, "jsonPath")
+
+		path := jsonpath.New(name).AllowMissingKeys(true)
+		if err := path.Parse(fmt.Sprintf("{%s}", jsonPath)); err != nil {
+			continue
+		}
+		columns = append(columns, printerColumn{
+			definition: metaV1.TableColumnDefinition{Name: name, Type: columnType, Format: format, Description: description, Priority: int32(priority)},
+			path:       path,
+		})
+	}
+
+	p := printer{
+		cells: func(obj *unstructured.Unstructured, now time.Time) ([]interface{}, error) {
+			cells := make([]interface{}, 0, len(columns))
+			for _, column := range columns {
+				cells = append(cells, column.cell(obj, now))
+			}
+			return cells, nil
+		},
+	}
+	for _, column := range columns {
+		p.columns = append(p.columns, column.definition)
+	}
+	return p
+}
+
+// cell evaluates the column for the object, the cell is nil if the path does not exist
+// Like the API server, dates are printed as the time since and other values keep the type of the column
+func (c printerColumn) cell(obj *unstructured.Unstructured, now time.Time) interface{} {
+	results, err := c.path.FindResults(obj.Object)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 || !results[0][0].CanInterface() {
+		return nil
+	}
+	value := results[0][0].Interface()
+
+	switch c.definition.Type {
+	case "integer":
+		if number, ok := value.(int64); ok {
+			return number
+		}
+		if number, ok := value.(float64); ok {
+			return int64(number)
+		}
+	case "number":
+		if number, ok := value.(float64); ok {
+			return number
+		}
+		if number, ok := value.(int64); ok {
+			return float64(number)
+		}
+	case "boolean":
+		if boolean, ok := value.(bool); ok {
+			return boolean
+		}
+	case "date":
+		if timestamp, ok := value.(string); ok {
+			if parsed, err := time.Parse(time.RFC3339, timestamp); err == nil {
+				return age(metaV1.NewTime(parsed), now)
+			}
+		}
+	default:
+		var buf bytes.Buffer
+		if err := c.path.PrintResults(&buf, []reflect.Value{results[0][0]}); err == nil {
+			return buf.String()
+		}
+	}
+	return nil
+}
+
+// partialObjectMetadata returns the encoded metadata of the object a row is for, like kubectl's includeObject=Metadata
+func partialObjectMetadata(obj *unstructured.Unstructured) []byte {
+	metadata := metaV1.PartialObjectMetadata{
+		TypeMeta: metaV1.TypeMeta{APIVersion: obj.GetAPIVersion(), Kind: obj.GetKind()},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:              obj.GetName(),
+			Namespace:         obj.GetNamespace(),
+			UID:               obj.GetUID(),
+			ResourceVersion:   obj.GetResourceVersion(),
+			CreationTimestamp: obj.GetCreationTimestamp(),
+			DeletionTimestamp: obj.GetDeletionTimestamp(),
+			Labels:            obj.GetLabels(),
+		},
+	}
+	data, _ := json.Marshal(metadata)
+	return data
+}
+
+// completed returns whether the resource has run to completion, such rows are greyed out by kubectl
+func completed(obj *unstructured.Unstructured) bool {
+	if obj.GroupVersionKind().GroupKind() != (schema.GroupKind{Kind: "Pod"}) {
+		return false
+	}
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	return phase == "Succeeded" || phase == "Failed"
+}
+
+// age returns the time since the timestamp in the short form used by kubectl, e.g. 5m or 3d4h
+func age(timestamp metaV1.Time, now time.Time) string {
+	if timestamp.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(now.Sub(timestamp.Time))
+}
+
+// orNone returns the value or <none> if it is empty
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+// joinOrNone joins the values with commas or returns <none> if there are none
+func joinOrNone(values []string) string {
+	return orNone(strings.Join(values, ","))
+}
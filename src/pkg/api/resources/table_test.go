@@ -0,0 +1,327 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var tableNow = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// tableObject returns an object created 5 minutes before tableNow
+func tableObject(apiVersion, kind, name string, fields map[string]interface{}) unstructured.Unstructured {
+	obj := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         "default",
+			"uid":               name + "-uid",
+			"creationTimestamp": tableNow.Add(-5 * time.Minute).Format(time.RFC3339),
+		},
+	}
+	for key, value := range fields {
+		obj[key] = value
+	}
+	return unstructured.Unstructured{Object: obj}
+}
+
+func columnNames(table *metav1.Table) []string {
+	var names []string
+	for _, column := range table.ColumnDefinitions {
+		names = append(names, column.Name)
+	}
+	return names
+}
+
+func TestTablePods(t *testing.T) {
+	containers := map[string]interface{}{
+		"containers": []interface{}{map[string]interface{}{"name": "app"}, map[string]interface{}{"name": "sidecar"}},
+		"nodeName":   "node-1",
+	}
+	running := map[string]interface{}{"running": map[string]interface{}{}}
+
+	tests := []struct {
+		name      string
+		status    map[string]interface{}
+		metadata  map[string]interface{}
+		want      []interface{}
+		completed bool
+	}{
+		{
+			name: "Running",
+			status: map[string]interface{}{
+				"phase": "Running",
+				"podIP": "10.0.0.1",
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"name": "app", "ready": true, "restartCount": int64(2), "state": running},
+					map[string]interface{}{"name": "sidecar", "ready": true, "restartCount": int64(1), "state": running},
+				},
+			},
+			want: []interface{}{"web", "2/2", "Running", int64(3), "5m", "10.0.0.1", "node-1"},
+		},
+		{
+			name: "CrashLoopBackOff",
+			status: map[string]interface{}{
+				"phase": "Running",
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"name": "app", "ready": false, "restartCount": int64(7), "state": map[string]interface{}{
+						"waiting": map[string]interface{}{"reason": "CrashLoopBackOff"},
+					}},
+					map[string]interface{}{"name": "sidecar", "ready": true, "state": running},
+				},
+			},
+			want: []interface{}{"web", "1/2", "CrashLoopBackOff", int64(7), "5m", "<none>", "node-1"},
+		},
+		{
+			name: "Init container waiting",
+			status: map[string]interface{}{
+				"phase": "Pending",
+				"initContainerStatuses": []interface{}{
+					map[string]interface{}{"name": "init", "ready": false, "state": map[string]interface{}{
+						"waiting": map[string]interface{}{"reason": "PodInitializing"},
+					}},
+				},
+			},
+			want: []interface{}{"web", "0/2", "Init:0/1", int64(0), "5m", "<none>", "node-1"},
+		},
+		{
+			name: "Init container failed",
+			status: map[string]interface{}{
+				"phase": "Pending",
+				"initContainerStatuses": []interface{}{
+					map[string]interface{}{"name": "init", "ready": false, "restartCount": int64(1), "state": map[string]interface{}{
+						"terminated": map[string]interface{}{"exitCode": int64(3)},
+					}},
+				},
+			},
+			want: []interface{}{"web", "0/2", "Init:ExitCode:3", int64(1), "5m", "<none>", "node-1"},
+		},
+		{
+			name: "Completed",
+			status: map[string]interface{}{
+				"phase": "Succeeded",
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"name": "app", "state": map[string]interface{}{
+						"terminated": map[string]interface{}{"reason": "Completed", "exitCode": int64(0)},
+					}},
+				},
+			},
+			want:      []interface{}{"web", "0/2", "Completed", int64(0), "5m", "<none>", "node-1"},
+			completed: true,
+		},
+		{
+			name:     "Terminating",
+			status:   map[string]interface{}{"phase": "Running"},
+			metadata: map[string]interface{}{"deletionTimestamp": tableNow.Format(time.RFC3339)},
+			want:     []interface{}{"web", "0/2", "Terminating", int64(0), "5m", "<none>", "node-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := tableObject("v1", "Pod", "web", map[string]interface{}{"spec": containers, "status": tt.status})
+			pod.Object["spec"].(map[string]interface{})["initContainers"] = []interface{}{map[string]interface{}{"name": "init"}}
+			for key, value := range tt.metadata {
+				pod.Object["metadata"].(map[string]interface{})[key] = value
+			}
+
+			list := &ResourceList{gvk: schema.GroupVersionKind{Version: "v1", Kind: "Pod"}}
+			table := list.table([]unstructured.Unstructured{pod}, tableNow)
+
+			require.Equal(t, []string{"Name", "Ready", "Status", "Restarts", "Age", "IP", "Node"}, columnNames(table))
+			require.Len(t, table.Rows, 1)
+			require.Equal(t, tt.want, table.Rows[0].Cells)
+			require.Equal(t, tt.completed, len(table.Rows[0].Conditions) > 0)
+		})
+	}
+}
+
+func TestTableKinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		gvk     schema.GroupVersionKind
+		object  unstructured.Unstructured
+		columns []string
+		cells   []interface{}
+	}{
+		{
+			name: "Deployment",
+			gvk:  schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			object: tableObject("apps/v1", "Deployment", "web", map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}},
+					"template": map[string]interface{}{"spec": map[string]interface{}{
+						"containers": []interface{}{map[string]interface{}{"name": "app", "image": "nginx"}},
+					}},
+				},
+				"status": map[string]interface{}{"readyReplicas": int64(2), "updatedReplicas": int64(3), "availableReplicas": int64(2)},
+			}),
+			columns: []string{"Name", "Ready", "Up-to-date", "Available", "Age", "Containers", "Images", "Selector"},
+			cells:   []interface{}{"web", "2/3", int64(3), int64(2), "5m", "app", "nginx", "app=web"},
+		},
+		{
+			name: "Job",
+			gvk:  schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+			object: tableObject("batch/v1", "Job", "migrate", map[string]interface{}{
+				"spec": map[string]interface{}{"completions": int64(1), "template": map[string]interface{}{}},
+				"status": map[string]interface{}{
+					"succeeded":      int64(1),
+					"startTime":      tableNow.Add(-4 * time.Minute).Format(time.RFC3339),
+					"completionTime": tableNow.Add(-3 * time.Minute).Format(time.RFC3339),
+					"conditions":     []interface{}{map[string]interface{}{"type": "Complete", "status": "True"}},
+				},
+			}),
+			columns: []string{"Name", "Status", "Completions", "Duration", "Age", "Containers", "Images", "Selector"},
+			cells:   []interface{}{"migrate", "Complete", "1/1", "60s", "5m", "", "", "<none>"},
+		},
+		{
+			name: "Node",
+			gvk:  schema.GroupVersionKind{Version: "v1", Kind: "Node"},
+			object: func() unstructured.Unstructured {
+				node := tableObject("v1", "Node", "node-1", map[string]interface{}{
+					"spec": map[string]interface{}{"unschedulable": true},
+					"status": map[string]interface{}{
+						"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+						"addresses":  []interface{}{map[string]interface{}{"type": "InternalIP", "address": "192.168.1.10"}},
+						"nodeInfo":   map[string]interface{}{"kubeletVersion": "v1.31.2"},
+					},
+				})
+				node.SetLabels(map[string]string{"node-role.kubernetes.io/control-plane": "", "node-role.kubernetes.io/etcd": ""})
+				return node
+			}(),
+			columns: []string{"Name", "Status", "Roles", "Age", "Version", "Internal-IP", "OS-Image", "Kernel-Version", "Container-Runtime"},
+			cells:   []interface{}{"node-1", "Ready,SchedulingDisabled", "control-plane,etcd", "5m", "v1.31.2", "192.168.1.10", "", "", ""},
+		},
+		{
+			name: "Service",
+			gvk:  schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+			object: tableObject("v1", "Service", "gateway", map[string]interface{}{
+				"spec": map[string]interface{}{
+					"type":      "LoadBalancer",
+					"clusterIP": "10.43.0.10",
+					"ports": []interface{}{
+						map[string]interface{}{"port": int64(443), "nodePort": int64(30443), "protocol": "TCP"},
+					},
+				},
+				"status": map[string]interface{}{"loadBalancer": map[string]interface{}{}},
+			}),
+			columns: []string{"Name", "Type", "Cluster-IP", "External-IP", "Port(s)", "Age", "Selector"},
+			cells:   []interface{}{"gateway", "LoadBalancer", "10.43.0.10", "<pending>", "443:30443/TCP", "5m", "<none>"},
+		},
+		{
+			name: "PersistentVolumeClaim",
+			gvk:  schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"},
+			object: tableObject("v1", "PersistentVolumeClaim", "data", map[string]interface{}{
+				"spec": map[string]interface{}{"volumeName": "pv-1", "storageClassName": "local-path"},
+				"status": map[string]interface{}{
+					"phase":       "Bound",
+					"capacity":    map[string]interface{}{"storage": "10Gi"},
+					"accessModes": []interface{}{"ReadWriteOnce"},
+				},
+			}),
+			columns: []string{"Name", "Status", "Volume", "Capacity", "Access Modes", "StorageClass", "Age", "VolumeMode"},
+			cells:   []interface{}{"data", "Bound", "pv-1", "10Gi", "RWO", "local-path", "5m", "Filesystem"},
+		},
+		{
+			name: "Secret",
+			gvk:  schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+			object: tableObject("v1", "Secret", "creds", map[string]interface{}{
+				"type": "Opaque",
+				"data": map[string]interface{}{"username": "YWRtaW4=", "password": "c2VjcmV0"},
+			}),
+			columns: []string{"Name", "Type", "Data", "Age"},
+			cells:   []interface{}{"creds", "Opaque", int64(2), "5m"},
+		},
+		{
+			name:    "Kind without a printer",
+			gvk:     schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"},
+			object:  tableObject("example.com/v1", "Widget", "widget", nil),
+			columns: []string{"Name", "Age"},
+			cells:   []interface{}{"widget", "5m"},
+		},
+		{
+			name:    "Invalid object keeps its row",
+			gvk:     schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			object:  tableObject("apps/v1", "Deployment", "broken", map[string]interface{}{"spec": "invalid"}),
+			columns: []string{"Name", "Ready", "Up-to-date", "Available", "Age", "Containers", "Images", "Selector"},
+			cells:   []interface{}{"broken", nil, nil, nil, nil, nil, nil, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &ResourceList{gvk: tt.gvk}
+			table := list.table([]unstructured.Unstructured{tt.object}, tableNow)
+
+			require.Equal(t, "Table", table.Kind)
+			require.Equal(t, tt.columns, columnNames(table))
+			require.Len(t, table.Rows, 1)
+			require.Equal(t, tt.cells, table.Rows[0].Cells)
+			require.Len(t, table.Rows[0].Cells, len(table.ColumnDefinitions))
+		})
+	}
+}
+
+func TestTableRowObject(t *testing.T) {
+	list := &ResourceList{gvk: schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}}
+	table := list.table([]unstructured.Unstructured{tableObject("v1", "ConfigMap", "settings", nil)}, tableNow)
+
+	var metadata metav1.PartialObjectMetadata
+	require.NoError(t, json.Unmarshal(table.Rows[0].Object.Raw, &metadata))
+	require.Equal(t, "ConfigMap", metadata.Kind)
+	require.Equal(t, "settings", metadata.Name)
+	require.Equal(t, "default", metadata.Namespace)
+	require.Equal(t, "settings-uid", string(metadata.UID))
+}
+
+func TestTableCustomResourceColumns(t *testing.T) {
+	crds := &ResourceList{Resources: make(map[string]*unstructured.Unstructured)}
+	crds.Resources["crd-1"] = &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "packages.uds.dev", "uid": "crd-1"},
+		"spec": map[string]interface{}{
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1alpha1", "additionalPrinterColumns": []interface{}{
+					map[string]interface{}{"name": "Status", "type": "string", "jsonPath": ".status.phase"},
+					map[string]interface{}{"name": "Endpoints", "type": "string", "jsonPath": ".status.endpoints"},
+					map[string]interface{}{"name": "Retries", "type": "integer", "jsonPath": ".status.retryAttempt", "priority": int64(1)},
+					map[string]interface{}{"name": "Ready", "type": "boolean", "jsonPath": ".status.ready"},
+					map[string]interface{}{"name": "Age", "type": "date", "jsonPath": ".metadata.creationTimestamp"},
+					map[string]interface{}{"name": "Invalid", "type": "string", "jsonPath": ".status[["},
+				}},
+			},
+		},
+	}}
+
+	list := &ResourceList{
+		gvk:  schema.GroupVersionKind{Group: "uds.dev", Version: "v1alpha1", Kind: "Package"},
+		GVR:  schema.GroupVersionResource{Group: "uds.dev", Version: "v1alpha1", Resource: "packages"},
+		crds: crds,
+	}
+	pkg := tableObject("uds.dev/v1alpha1", "Package", "podinfo", map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase":        "Ready",
+			"endpoints":    []interface{}{"podinfo.uds.dev", "api.uds.dev"},
+			"retryAttempt": int64(0),
+		},
+	})
+
+	table := list.table([]unstructured.Unstructured{pkg}, tableNow)
+	require.Equal(t, []string{"Name", "Status", "Endpoints", "Retries", "Ready", "Age"}, columnNames(table))
+	require.Equal(t, int32(1), table.ColumnDefinitions[3].Priority)
+	require.Equal(t, []interface{}{"podinfo", "Ready", `["podinfo.uds.dev","api.uds.dev"]`, int64(0), nil, "5m"}, table.Rows[0].Cells)
+
+	// Versions without printer columns fall back to the name and age
+	list.GVR.Version = "v1"
+	table = list.table([]unstructured.Unstructured{pkg}, tableNow)
+	require.Equal(t, []string{"Name", "Age"}, columnNames(table))
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package resources
+
+import (
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/telemetry"
+)
+
+var (
+	cacheEvents = telemetry.NewCounter("uds_runtime_cache_events_total",
+		"Number of informer events handled by the cache", "kind", "type")
+	metricsCollectionDuration = telemetry.NewHistogram("uds_runtime_metrics_collection_duration_seconds",
+		"Duration of pod and node metrics collection", []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30})
+	metricsCollectionErrors = telemetry.NewCounter("uds_runtime_metrics_collection_errors_total",
+		"Number of failed metrics collections, by what failed", "stage")
+)
+
+// RegisterCacheMetrics registers gauges reporting the sync status and object count of each kind in the current cache
+func RegisterCacheMetrics(current func() *Cache) {
+	collect := func(value func(r *ResourceList) float64) func() []telemetry.Sample {
+		return func() []telemetry.Sample {
+			c := current()
+			if c == nil {
+				return nil
+			}
+			var samples []telemetry.Sample
+			for _, r := range c.resourceLists() {
+				if r == nil {
+					continue
+				}
+				samples = append(samples, telemetry.Sample{LabelValues: []string{r.GVK().Kind}, Value: value(r)})
+			}
+			return samples
+		}
+	}
+
+	telemetry.NewGaugeFunc("uds_runtime_cache_synced", "Whether the informers of a kind have synced, 1 if they have",
+		[]string{"kind"}, collect(func(r *ResourceList) float64 {
+			if r.HasSynced != nil && r.HasSynced() {
+				return 1
+			}
+			return 0
+		}))
+	telemetry.NewGaugeFunc("uds_runtime_cache_objects", "Number of cached objects of a kind",
+		[]string{"kind"}, collect(func(r *ResourceList) float64 {
+			r.mutex.RLock()
+			defer r.mutex.RUnlock()
+			return float64(len(r.Resources))
+		}))
+}
@@ -28,3 +28,11 @@ func ToUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
 	}
 	return &unstructured.Unstructured{Object: unstructuredMap}, nil
 }
+
+// unwrapDeleted returns the object of a DeletedFinalStateUnknown, other objects are returned as is
+func unwrapDeleted(obj interface{}) interface{} {
+	if deletedObj, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		return unwrapDeleted(deletedObj.Obj)
+	}
+	return obj
+}
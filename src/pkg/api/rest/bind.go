@@ -4,11 +4,18 @@
 package rest
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
 	"github.com/go-chi/chi/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func handleRequest(w http.ResponseWriter, r *http.Request, resource *resources.ResourceList) {
@@ -18,50 +25,265 @@ func handleRequest(w http.ResponseWriter, r *http.Request, resource *resources.R
 	namespace := r.URL.Query().Get("namespace")
 	namePartial := r.URL.Query().Get("name")
 	uid := chi.URLParam(r, "uid")
+	// Single resources may also be looked up by the namespace and name URL params
+	resourceNamespace := chi.URLParam(r, "namespace")
+	resourceName := chi.URLParam(r, "name")
+	single := uid != "" || resourceName != ""
 	once := r.URL.Query().Get("once") == "true"
 	fields := r.URL.Query().Get("fields")
+	format := r.URL.Query().Get("format")
+
+	// The cache is limited to some namespaces and RBAC does not allow watching this kind
+	if resource.Forbidden {
+		http.Error(w, fmt.Sprintf("Not allowed to list %s resources", resource.GVK().Kind), http.StatusForbidden)
+		return
+	}
 
 	var fieldsList []string
 	if fields != "" {
-		fieldsList = strings.Split(fields, ",")
+		fieldsList = splitFields(fields)
+		for _, field := range fieldsList {
+			if isLegacyFieldPath(field) {
+				continue
+			}
+			if _, err := parseFieldExpression(field); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
 		// If fields are specified, dense data retrieval is required
 		dense = true
 	}
 
+	switch format {
+	case "":
+	case "table":
+		if fields != "" {
+			http.Error(w, "fields cannot be used with the table format", http.StatusBadRequest)
+			return
+		}
+		// Table columns are computed from the full resources
+		dense = true
+	default:
+		http.Error(w, fmt.Sprintf("invalid format %q, must be table", format), http.StatusBadRequest)
+		return
+	}
+
+	// Once and single resource responses can be sent as JSON, YAML, CSV or NDJSON
+	output, err := parseOutput(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if output != outputJSON {
+		switch {
+		case !once && !single:
+			http.Error(w, fmt.Sprintf("%s output requires once or a single resource", output), http.StatusBadRequest)
+			return
+		case format == "table":
+			http.Error(w, "the table format can only be sent as json", http.StatusBadRequest)
+			return
+		case output == outputYAML && fields != "":
+			http.Error(w, "fields cannot be used with yaml output", http.StatusBadRequest)
+			return
+		case output == outputYAML || output == outputCSV:
+			// YAML is sent as full resources and CSV columns may select any field
+			dense = true
+		}
+	}
+
 	// Get the data from the cache as sparse by default
 	getData := resource.GetSparseResources
 	if dense {
 		getData = resource.GetResources
 	}
 
-	// If a UID is provided, send the data for that UID
-	// Streaming is not supported for single resources
-	if uid != "" {
-		// If a namespace or name is provided, return a 400
+	// Filter by label and field selectors, they are evaluated on the full resources in either case
+	selector, err := resources.ParseSelector(r.URL.Query().Get("labelSelector"), r.URL.Query().Get("fieldSelector"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !selector.Empty() {
+		getData = func(namespace, namePartial string) []unstructured.Unstructured {
+			return resource.GetSelectedResources(namespace, namePartial, selector, !dense)
+		}
+	}
+
+	// Limit the data to the namespaces the auth policy allows, if any
+	if allowedNamespaces, scoped := cluster.NamespaceScope(r.Context()); scoped {
+		if namespace != "" && !slices.Contains(allowedNamespaces, namespace) {
+			http.Error(w, "Namespace not allowed", http.StatusForbidden)
+			return
+		}
+		getData = scopeToNamespaces(getData, allowedNamespaces)
+	}
+
+	// Limit the data to what the impersonated user's RBAC allows, if impersonation is enabled
+	if _, impersonated := cluster.UserFromContext(r.Context()); impersonated {
+		getData = filterByAccess(r.Context(), getData, resource.GVR)
+	}
+
+	// Sort the list stably and page through it if a limit is set
+	opts, err := parseListOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	mode := r.URL.Query().Get("mode")
+	if mode != "" && mode != "full" && mode != "delta" {
+		http.Error(w, fmt.Sprintf("invalid mode %q, must be full or delta", mode), http.StatusBadRequest)
+		return
+	}
+	if mode == "delta" && opts.limit > 0 {
+		http.Error(w, "limit cannot be used with delta mode", http.StatusBadRequest)
+		return
+	}
+	if mode == "delta" && format == "table" {
+		http.Error(w, "the table format cannot be used with delta mode", http.StatusBadRequest)
+		return
+	}
+	getPayload := func() any {
+		// The sort path is evaluated on the full resources, sparse resources are looked up by UID
+		payload := opts.apply(getData(namespace, namePartial), func(item unstructured.Unstructured) map[string]interface{} {
+			if dense {
+				return item.Object
+			}
+			full, _ := resource.GetResource(string(item.GetUID()))
+			return full.Object
+		})
+		if format == "table" {
+			return toTable(resource, payload)
+		}
+
+		// Reuse the encodings cached by the list rather than encoding every resource again
+		return cachedPayload{payload: payload, cachedJSON: func(items []unstructured.Unstructured) [][]byte {
+			return resource.CachedJSON(items, !dense)
+		}}
+	}
+
+	// If a UID or namespace and name are provided, send the data for that resource
+	if single {
+		// If a namespace or name query param is provided, return a 400
 		if namespace != "" || namePartial != "" {
-			http.Error(w, "Namespace and Name cannot be used with UID", http.StatusBadRequest)
+			http.Error(w, "Namespace and Name cannot be used with a single resource", http.StatusBadRequest)
 			return
 		}
 
-		data, found := resource.GetResource(uid)
-		// If the resource is not found, return a 404
+		// Resources that are not accessible to the user are treated as not found
+		getResource := func() (unstructured.Unstructured, bool) {
+			data, found := resource.GetResource(uid)
+			if resourceName != "" {
+				data, found = resource.GetResourceByName(resourceNamespace, resourceName)
+			}
+			return data, found && cluster.NamespaceAllowed(r.Context(), data.GetNamespace()) &&
+				cluster.ResourceAllowed(r.Context(), "get", resource.GVR, data.GetNamespace(), data.GetName())
+		}
+		getSinglePayload := func(data unstructured.Unstructured) any {
+			if format == "table" {
+				return resource.Table([]unstructured.Unstructured{data})
+			}
+			return data
+		}
+
+		data, found := getResource()
+		// If the resource is not found or not accessible to the user, return a 404
 		if !found {
 			http.Error(w, "Resource not found", http.StatusNotFound)
 			return
 		}
 
-		writeData(w, data, fieldsList, resource.CRDExists)
+		// If stream is true, send the resource as an SSE stream whenever it changes
+		if r.URL.Query().Get("stream") == "true" {
+			if output != outputJSON {
+				http.Error(w, fmt.Sprintf("%s output cannot be streamed", output), http.StatusBadRequest)
+				return
+			}
+
+			// A UID identifies a single object, only its changes are notified and the stream ends when it is deleted
+			// A name may be reused by a new object, the stream follows every change to the list and outlives deletions
+			if uid != "" {
+				changes, unsubscribe := resource.Changes.SubscribeKey(uid)
+				defer unsubscribe()
+				streamResource(w, r, getResource, getSinglePayload, changes, fieldsList, true)
+				return
+			}
+			changes, unsubscribe := resource.Changes.Subscribe()
+			defer unsubscribe()
+			streamResource(w, r, getResource, getSinglePayload, changes, fieldsList, false)
+			return
+		}
+
+		if format == "table" {
+			writeData(w, getSinglePayload(data), nil, resource.CRDExists)
+			return
+		}
+		writeOutput(w, output, data, fieldsList, resource.CRDExists)
 		return
 	}
 
 	// If once is true, send the list data once and close the connection
 	if once {
-		writeData(w, getData(namespace, namePartial), fieldsList, resource.CRDExists)
+		writeOutput(w, output, getPayload(), fieldsList, resource.CRDExists)
+		return
+	}
+
+	// In delta mode, send a snapshot once and then only the changed resources
+	if mode == "delta" {
+		streamDeltas(w, r, resource, getPayload, deltaFilter(r, resource, namespace, namePartial, selector), dense, fieldsList)
 		return
 	}
 
 	// Otherwise, send the data as an SSE stream
-	Handler(w, r, getData, resource.Changes, fieldsList, resource.CRDExistsInCluster)
+	changes, unsubscribe := resource.Changes.Subscribe()
+	defer unsubscribe()
+	stream(w, r, getPayload, changes, fieldsList, resource.CRDExistsInCluster)
+}
+
+// deltaFilter returns whether a changed resource belongs to the list requested, applying the same filters as the list
+func deltaFilter(r *http.Request, resource *resources.ResourceList, namespace, namePartial string, selector resources.Selector) func(*unstructured.Unstructured) bool {
+	allowedNamespaces, scoped := cluster.NamespaceScope(r.Context())
+	return func(item *unstructured.Unstructured) bool {
+		return (namespace == "" || item.GetNamespace() == namespace) &&
+			strings.Contains(item.GetName(), namePartial) &&
+			selector.Matches(item) &&
+			(!scoped || slices.Contains(allowedNamespaces, item.GetNamespace())) &&
+			cluster.ResourceAllowed(r.Context(), "list", resource.GVR, item.GetNamespace(), "")
+	}
+}
+
+// scopeToNamespaces wraps getData to only return resources in the given namespaces
+func scopeToNamespaces(getData func(string, string) []unstructured.Unstructured, namespaces []string) func(string, string) []unstructured.Unstructured {
+	return func(namespace, namePartial string) []unstructured.Unstructured {
+		scoped := make([]unstructured.Unstructured, 0)
+		for _, item := range getData(namespace, namePartial) {
+			if slices.Contains(namespaces, item.GetNamespace()) {
+				scoped = append(scoped, item)
+			}
+		}
+		return scoped
+	}
+}
+
+// filterByAccess wraps getData to only return resources in namespaces where the request's user may list them
+// Decisions are made per namespace, cluster-scoped resources are checked cluster-wide
+func filterByAccess(ctx context.Context, getData func(string, string) []unstructured.Unstructured, gvr schema.GroupVersionResource) func(string, string) []unstructured.Unstructured {
+	return func(namespace, namePartial string) []unstructured.Unstructured {
+		allowed := make(map[string]bool)
+		filtered := make([]unstructured.Unstructured, 0)
+		for _, item := range getData(namespace, namePartial) {
+			itemNamespace := item.GetNamespace()
+			namespaceAllowed, reviewed := allowed[itemNamespace]
+			if !reviewed {
+				namespaceAllowed = cluster.ResourceAllowed(ctx, "list", gvr, itemNamespace, "")
+				allowed[itemNamespace] = namespaceAllowed
+			}
+			if namespaceAllowed {
+				filtered = append(filtered, item)
+			}
+		}
+		return filtered
+	}
 }
 
 func Bind(resource *resources.ResourceList) func(w http.ResponseWriter, r *http.Request) {
@@ -78,6 +300,31 @@ func BindCustomResource(resource *resources.ResourceList, cache *resources.Cache
 	}
 }
 
+// BindDynamicCustomResource binds any custom resource identified by the group, version and resource URL params
+// The informer for the resource is started on the first request and released when the request completes
+func BindDynamicCustomResource(customResources *resources.CustomResources) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		gvr := schema.GroupVersionResource{
+			Group:    chi.URLParam(r, "group"),
+			Version:  chi.URLParam(r, "version"),
+			Resource: chi.URLParam(r, "resource"),
+		}
+
+		resource, release, err := customResources.Acquire(r.Context(), gvr)
+		if err != nil {
+			if errors.Is(err, resources.ErrCRDNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer release()
+
+		handleRequest(w, r, resource)
+	}
+}
+
 // writeData writes the payload to the http.ResponseWriter
 // It handles field filtering if specific fields are requested and checks for CRD
 func writeData(w http.ResponseWriter, payload any, fieldsList []string, crdExists bool) {
@@ -93,7 +340,7 @@ func writeData(w http.ResponseWriter, payload any, fieldsList []string, crdExist
 	}
 
 	// Set the headers
-	w.Header().Set("Content-Type", "text/json; charset=utf-8")
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
 
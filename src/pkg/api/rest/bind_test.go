@@ -6,16 +6,24 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
 	"github.com/defenseunicorns/uds-runtime/src/test"
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/require"
+	authorizationV1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestBind(t *testing.T) {
@@ -70,6 +78,29 @@ func TestBind(t *testing.T) {
 				`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"mock-pod-2","namespace":"uds-dev-stack","uid":"2"}}`,
 			},
 		},
+		{
+			name:           "Get sorted resources",
+			url:            "/resources/workloads/pods?once=true&dense=true&sort=.metadata.uid&order=desc",
+			expectedStatus: http.StatusOK,
+			expectedResponse: []string{
+				`[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"mock-pod-2","namespace":"uds-dev-stack","uid":"2"}},` +
+					`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"mock-pod-1","namespace":"uds-dev-stack","uid":"1"}}]`,
+			},
+		},
+		{
+			name:           "Get a page of resources",
+			url:            "/resources/workloads/pods?once=true&limit=1",
+			expectedStatus: http.StatusOK,
+			expectedResponse: []string{
+				`{"items":[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"mock-pod-1-sparse","namespace":"uds-dev-stack","uid":"1"}}],"metadata":{"total":2,"continue":"`,
+			},
+		},
+		{
+			name:             "Get resources with an invalid limit",
+			url:              "/resources/workloads/pods?once=true&limit=-1",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: []string{"invalid limit"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -122,6 +153,533 @@ func TestBind(t *testing.T) {
 	})
 }
 
+func TestBindNamespaceScope(t *testing.T) {
+	resourceList := &resources.ResourceList{
+		Resources:       make(map[string]*unstructured.Unstructured),
+		SparseResources: make(map[string]*unstructured.Unstructured),
+		CRDExists:       true,
+	}
+	resourceList.Resources["1"] = test.CreateMockPod("mock-pod-1", "team-a", "1")
+	resourceList.Resources["2"] = test.CreateMockPod("mock-pod-2", "team-b", "2")
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+	r.Get("/pods/{uid}", Bind(resourceList))
+
+	tests := []struct {
+		name             string
+		url              string
+		expectedStatus   int
+		expectedResponse string
+		excludedResponse string
+	}{
+		{
+			name:             "List only returns allowed namespaces",
+			url:              "/pods?once=true&dense=true",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "mock-pod-1",
+			excludedResponse: "mock-pod-2",
+		},
+		{
+			name:             "List without matches is empty",
+			url:              "/pods?once=true&dense=true&name=missing",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "[]",
+			excludedResponse: "null",
+		},
+		{
+			name:           "List of another namespace is forbidden",
+			url:            "/pods?once=true&namespace=team-b",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:             "Get resource in an allowed namespace",
+			url:              "/pods/1",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "mock-pod-1",
+		},
+		{
+			name:           "Get resource in another namespace",
+			url:            "/pods/2",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			req = req.WithContext(cluster.WithNamespaceScope(req.Context(), []string{"team-a"}))
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.expectedResponse)
+			if tt.excludedResponse != "" {
+				require.NotContains(t, rr.Body.String(), tt.excludedResponse)
+			}
+		})
+	}
+}
+
+func TestBindImpersonation(t *testing.T) {
+	// Only team-a secrets are accessible to the user
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationV1.SubjectAccessReview)
+		review.Status.Allowed = review.Spec.User == "doug" && review.Spec.ResourceAttributes.Namespace == "team-a"
+		return true, review, nil
+	})
+	cluster.SetAccessReviewer(cluster.NewAccessReviewer(clientset, time.Minute))
+	defer cluster.SetAccessReviewer(nil)
+
+	resourceList := &resources.ResourceList{
+		Resources:       make(map[string]*unstructured.Unstructured),
+		SparseResources: make(map[string]*unstructured.Unstructured),
+		GVR:             schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		CRDExists:       true,
+	}
+	resourceList.Resources["1"] = test.CreateMockPod("mock-secret-1", "team-a", "1")
+	resourceList.Resources["2"] = test.CreateMockPod("mock-secret-2", "team-b", "2")
+
+	r := chi.NewRouter()
+	r.Get("/secrets", Bind(resourceList))
+	r.Get("/secrets/{uid}", Bind(resourceList))
+
+	tests := []struct {
+		name             string
+		url              string
+		impersonated     bool
+		expectedStatus   int
+		expectedResponse string
+		excludedResponse string
+	}{
+		{
+			name:             "List only returns resources the user may list",
+			url:              "/secrets?once=true&dense=true",
+			impersonated:     true,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "mock-secret-1",
+			excludedResponse: "mock-secret-2",
+		},
+		{
+			name:             "List without resources the user may list is empty",
+			url:              "/secrets?once=true&dense=true&name=mock-secret-2",
+			impersonated:     true,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "[]",
+			excludedResponse: "null",
+		},
+		{
+			name:             "Get resource the user may get",
+			url:              "/secrets/1",
+			impersonated:     true,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "mock-secret-1",
+		},
+		{
+			name:           "Get resource the user may not get",
+			url:            "/secrets/2",
+			impersonated:   true,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:             "Requests that are not impersonated are not filtered",
+			url:              "/secrets?once=true&dense=true",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "mock-secret-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			if tt.impersonated {
+				req = req.WithContext(cluster.WithUser(req.Context(), cluster.User{Name: "doug"}))
+			}
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.expectedResponse)
+			if tt.excludedResponse != "" {
+				require.NotContains(t, rr.Body.String(), tt.excludedResponse)
+			}
+		})
+	}
+}
+
+func TestBindSelectors(t *testing.T) {
+	resourceList := &resources.ResourceList{
+		Resources:       make(map[string]*unstructured.Unstructured),
+		SparseResources: make(map[string]*unstructured.Unstructured),
+		CRDExists:       true,
+	}
+	for uid, app := range map[string]string{"1": "web", "2": "db"} {
+		pod := test.CreateMockPod("mock-pod-"+uid, "uds-dev-stack", uid)
+		pod.SetLabels(map[string]string{"app": app})
+		resourceList.Resources[uid] = pod
+		resourceList.SparseResources[uid] = pod
+	}
+	resourceList.Resources["1"].Object["status"] = map[string]interface{}{"phase": "Running"}
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+		expectedNames  []string
+	}{
+		{
+			name:           "Label selector",
+			url:            "/pods?once=true&labelSelector=app%3Ddb",
+			expectedStatus: http.StatusOK,
+			expectedNames:  []string{"mock-pod-2"},
+		},
+		{
+			name:           "Field selector",
+			url:            "/pods?once=true&dense=true&fieldSelector=status.phase%3DRunning",
+			expectedStatus: http.StatusOK,
+			expectedNames:  []string{"mock-pod-1"},
+		},
+		{
+			name:           "No matches",
+			url:            "/pods?once=true&labelSelector=app%3Dweb&fieldSelector=status.phase%21%3DRunning",
+			expectedStatus: http.StatusOK,
+			expectedNames:  []string{},
+		},
+		{
+			name:           "Invalid label selector",
+			url:            "/pods?once=true&labelSelector=app%3D%3Dweb%3D%3Ddb",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid field selector",
+			url:            "/pods?fieldSelector=status.phase",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var data []unstructured.Unstructured
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &data))
+			names := []string{}
+			for _, item := range data {
+				names = append(names, item.GetName())
+			}
+			require.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestBindInvalidFields(t *testing.T) {
+	resource := &resources.ResourceList{}
+
+	req := httptest.NewRequest(http.MethodGet, "/?fields=.spec.containers%5Babc%5D.name", nil)
+	rec := httptest.NewRecorder()
+	handleRequest(rec, req, resource)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "invalid field")
+}
+
+func TestBindConcurrentStreams(t *testing.T) {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	informer.handler.OnAdd(test.CreateMockPod("mock-pod-1", "default", "1"), true)
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	// Every stream observes every change, not only the one that receives the notification first
+	const streams = 5
+	var connections []<-chan sseEvent
+	for i := 0; i < streams; i++ {
+		resp, err := http.Get(server.URL + "/pods")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		connections = append(connections, readEvents(t, resp))
+	}
+
+	for count := 1; count <= 3; count++ {
+		if count > 1 {
+			uid := fmt.Sprint(count)
+			informer.handler.OnAdd(test.CreateMockPod("mock-pod-"+uid, "default", uid), false)
+		}
+		for _, events := range connections {
+			var items []unstructured.Unstructured
+			select {
+			case event := <-events:
+				require.NoError(t, json.Unmarshal([]byte(event.raw), &items))
+			case <-time.After(2 * time.Second):
+				require.FailNow(t, "timed out waiting for an update", "update %d", count)
+			}
+			require.Len(t, items, count)
+		}
+	}
+	require.Equal(t, streams, resourceList.Changes.Subscribers())
+}
+
+func TestBindSingleResource(t *testing.T) {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	informer.handler.OnAdd(test.CreateMockPod("mock-pod", "uds-dev-stack", "1"), true)
+	informer.handler.OnAdd(test.CreateMockPod("mock-pod", "default", "2"), true)
+
+	r := chi.NewRouter()
+	r.Get("/pods/{uid}", Bind(resourceList))
+	r.Get("/pods/ns/{namespace}/name/{name}", Bind(resourceList))
+
+	tests := []struct {
+		name             string
+		url              string
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:             "Get resource by name",
+			url:              "/pods/ns/default/name/mock-pod",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"mock-pod","namespace":"default","uid":"2"}}`,
+		},
+		{
+			name:             "Get resource by name with fields",
+			url:              "/pods/ns/uds-dev-stack/name/mock-pod?fields=.metadata.uid",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"metadata":{"uid":"1"}}`,
+		},
+		{
+			name:             "Get resource by name as YAML",
+			url:              "/pods/ns/default/name/mock-pod?output=yaml",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: "kind: Pod",
+		},
+		{
+			name:             "Get resource by name as a table",
+			url:              "/pods/ns/default/name/mock-pod?format=table",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `"kind":"Table"`,
+		},
+		{
+			name:             "Get non-existent resource by name",
+			url:              "/pods/ns/kube-system/name/mock-pod",
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: "Resource not found",
+		},
+		{
+			name:             "Get resource by name with a name filter",
+			url:              "/pods/ns/default/name/mock-pod?name=mock",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "Namespace and Name cannot be used with a single resource",
+		},
+		{
+			name:             "Stream resource by name as YAML",
+			url:              "/pods/ns/default/name/mock-pod?stream=true&output=yaml",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: "yaml output cannot be streamed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+			require.Contains(t, rr.Body.String(), tt.expectedResponse)
+		})
+	}
+
+	t.Run("Stream resource by name", func(t *testing.T) {
+		server := httptest.NewServer(r)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/pods/ns/default/name/mock-pod?stream=true")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+		events := readEvents(t, resp)
+
+		event := nextEvent(t, events)
+		require.Empty(t, event.event)
+		require.Equal(t, "2", objectMetadata(event)["uid"])
+
+		// Changes to other resources are not sent
+		other := test.CreateMockPod("mock-pod", "uds-dev-stack", "1")
+		other.SetResourceVersion("5")
+		informer.handler.OnUpdate(nil, other)
+
+		modified := test.CreateMockPod("mock-pod", "default", "2")
+		modified.SetResourceVersion("2")
+		informer.handler.OnUpdate(nil, modified)
+		event = nextEvent(t, events)
+		require.Empty(t, event.event)
+		require.Equal(t, "2", objectMetadata(event)["resourceVersion"])
+
+		// Deleting the resource sends its last version, the stream continues if it is recreated
+		informer.handler.OnDelete(modified)
+		event = nextEvent(t, events)
+		require.Equal(t, "deleted", event.event)
+		require.Equal(t, "2", objectMetadata(event)["uid"])
+
+		informer.handler.OnAdd(test.CreateMockPod("mock-pod", "default", "3"), false)
+		event = nextEvent(t, events)
+		require.Empty(t, event.event)
+		require.Equal(t, "3", objectMetadata(event)["uid"])
+	})
+
+	t.Run("Stream resource by UID", func(t *testing.T) {
+		server := httptest.NewServer(r)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/pods/3?stream=true&fields=.metadata")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+		events := readEvents(t, resp)
+
+		event := nextEvent(t, events)
+		require.Equal(t, map[string]interface{}{"metadata": map[string]interface{}{"name": "mock-pod", "namespace": "default", "uid": "3"}}, event.data)
+
+		// Changes to other resources are not sent
+		other := test.CreateMockPod("mock-pod", "uds-dev-stack", "1")
+		other.SetResourceVersion("6")
+		informer.handler.OnUpdate(nil, other)
+
+		modified := test.CreateMockPod("mock-pod", "default", "3")
+		modified.SetResourceVersion("4")
+		informer.handler.OnUpdate(nil, modified)
+		event = nextEvent(t, events)
+		require.Empty(t, event.event)
+		require.Equal(t, "3", event.data["metadata"].(map[string]interface{})["uid"])
+		require.Equal(t, "4", event.data["metadata"].(map[string]interface{})["resourceVersion"])
+
+		// Deleting the resource sends its last version and ends the stream
+		informer.handler.OnDelete(modified)
+		event = nextEvent(t, events)
+		require.Equal(t, "deleted", event.event)
+		require.Equal(t, "4", event.data["metadata"].(map[string]interface{})["resourceVersion"])
+
+		select {
+		case _, open := <-events:
+			require.False(t, open)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for the stream to end")
+		}
+	})
+}
+
+// objectMetadata returns the metadata of the single resource sent in the event
+func objectMetadata(event sseEvent) map[string]interface{} {
+	metadata, _, _ := unstructured.NestedMap(event.data, "Object", "metadata")
+	return metadata
+}
+
+func TestBindForbidden(t *testing.T) {
+	nodes := resources.NewForbiddenResourceList(
+		schema.GroupVersionKind{Version: "v1", Kind: "Node"},
+		schema.GroupVersionResource{Version: "v1", Resource: "nodes"},
+	)
+
+	for _, url := range []string{"/nodes?once=true", "/nodes", "/nodes/1"} {
+		r := chi.NewRouter()
+		r.Get("/nodes", Bind(nodes))
+		r.Get("/nodes/{uid}", Bind(nodes))
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+		require.Contains(t, rr.Body.String(), "Node")
+	}
+}
+
+func TestBindTable(t *testing.T) {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	for i, name := range []string{"web", "db", "api"} {
+		pod := test.CreateMockPod(name, "default", fmt.Sprint(i+1))
+		pod.Object["spec"] = map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "app"}}}
+		pod.Object["status"] = map[string]interface{}{"phase": "Pending"}
+		informer.handler.OnAdd(pod, true)
+	}
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+	r.Get("/pods/{uid}", Bind(resourceList))
+
+	get := func(url string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		return rr
+	}
+
+	t.Run("List", func(t *testing.T) {
+		rr := get("/pods?once=true&format=table&sort=.metadata.name")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var table metav1.Table
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &table))
+		require.Equal(t, "Table", table.Kind)
+		require.Equal(t, "Name", table.ColumnDefinitions[0].Name)
+		require.Equal(t, "Status", table.ColumnDefinitions[2].Name)
+		require.Len(t, table.Rows, 3)
+		require.Equal(t, []interface{}{"api", "0/1", "Pending", float64(0), "<unknown>", "<none>", "<none>"}, table.Rows[0].Cells)
+		require.Equal(t, "db", table.Rows[1].Cells[0])
+	})
+
+	t.Run("Page", func(t *testing.T) {
+		rr := get("/pods?once=true&format=table&limit=2")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var table metav1.Table
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &table))
+		require.Len(t, table.Rows, 2)
+		require.NotEmpty(t, table.Continue)
+
+		rr = get("/pods?once=true&format=table&limit=2&continue=" + table.Continue)
+		var next metav1.Table
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &next))
+		require.Len(t, next.Rows, 1)
+		require.Empty(t, next.Continue)
+	})
+
+	t.Run("Single resource", func(t *testing.T) {
+		rr := get("/pods/2?format=table")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var table metav1.Table
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &table))
+		require.Len(t, table.Rows, 1)
+		require.Equal(t, "db", table.Rows[0].Cells[0])
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, url := range []string{
+			"/pods?once=true&format=wide",
+			"/pods?once=true&format=table&fields=.metadata.name",
+			"/pods?format=table&mode=delta",
+		} {
+			rr := get(url)
+			require.Equal(t, http.StatusBadRequest, rr.Code, url)
+		}
+	})
+}
+
 func TestWriteData(t *testing.T) {
 	rr := httptest.NewRecorder()
 	payload := map[string]string{"key": "value"}
@@ -134,8 +692,8 @@ func TestWriteData(t *testing.T) {
 	}
 
 	// Check the content type
-	if contentType := rr.Header().Get("Content-Type"); contentType != "text/json; charset=utf-8" {
-		t.Errorf("writeData returned wrong content type: got %v want %v", contentType, "text/json; charset=utf-8")
+	if contentType := rr.Header().Get("Content-Type"); contentType != "application/json; charset=utf-8" {
+		t.Errorf("writeData returned wrong content type: got %v want %v", contentType, "application/json; charset=utf-8")
 	}
 
 	// Check the response body
@@ -0,0 +1,204 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Snapshot is the type of the delta stream event carrying the full list
+const Snapshot = "SNAPSHOT"
+
+// deltaEvent is a change to a single resource sent in delta mode
+type deltaEvent struct {
+	Type   string          `json:"type"`
+	UID    string          `json:"uid"`
+	Object json.RawMessage `json:"object,omitempty"`
+}
+
+// snapshotEvent is the full list sent when a delta stream starts or has to resync
+type snapshotEvent struct {
+	Type  string          `json:"type"`
+	Items json.RawMessage `json:"items"`
+}
+
+// receivedUIDs tracks the resources the client of a delta stream holds, so deletions are only sent for those
+type receivedUIDs struct {
+	// uids is whether the client holds each resource seen by the stream
+	uids map[string]bool
+	// complete is set once uids is seeded from a snapshot, resources not in it are then not held by the client
+	// Otherwise the stream resumed without a snapshot, and the client holds an unseen resource if its previous state matched
+	complete bool
+}
+
+// seed resets the UIDs to the resources of a snapshot
+func (u *receivedUIDs) seed(items []unstructured.Unstructured, match func(*unstructured.Unstructured) bool) {
+	u.uids = make(map[string]bool)
+	u.complete = true
+	for i := range items {
+		if match(&items[i]) {
+			u.uids[string(items[i].GetUID())] = true
+		}
+	}
+}
+
+// held returns whether the client holds the resource of the event before it is applied
+func (u *receivedUIDs) held(event resources.ResourceEvent, match func(*unstructured.Unstructured) bool) bool {
+	if held, seen := u.uids[event.UID]; seen || u.complete {
+		return held
+	}
+	return event.Previous != nil && match(event.Previous)
+}
+
+// record sets whether the client holds the resource, resources it does not hold only need to be kept without a snapshot
+func (u *receivedUIDs) record(uid string, held bool) {
+	if held || !u.complete {
+		u.uids[uid] = held
+		return
+	}
+	delete(u.uids, uid)
+}
+
+// streamDeltas sends a snapshot of the list followed by an SSE event for each added, modified or deleted resource
+// Events are filtered with match, resources modified so that they no longer match are sent as deleted if the client
+// received them, deletions of resources it never received are not sent
+// Each event has an ID, clients reconnecting with a Last-Event-ID that is still in the replay log of the list
+// receive only the events they missed, otherwise a new snapshot is sent
+func streamDeltas(w http.ResponseWriter, r *http.Request, resource *resources.ResourceList, getPayload func() any, match func(*unstructured.Unstructured) bool, dense bool, fieldsList []string) {
+	WriteHeaders(w)
+
+	// Ensure the ResponseWriter supports flushing
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported!", http.StatusInternalServerError)
+		return
+	}
+
+	sseConnections.Inc()
+	defer sseConnections.Dec()
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	received := &receivedUIDs{uids: make(map[string]bool)}
+	send := func(event resources.ResourceEvent) {
+		lastEventID = event.ID
+		sendDelta(w, event, match, received, dense, fieldsList)
+	}
+
+	for {
+		// Subscribe before taking the snapshot so no change is missed, changes made in between are sent twice
+		subscription := resource.Subscribe(lastEventID)
+
+		switch {
+		case !resource.CRDExistsInCluster():
+			fmt.Fprintf(w, "data: {\"error\":\"crd not found\"}\n\n")
+		case subscription.Resumed:
+			for _, event := range subscription.Replay {
+				send(event)
+			}
+		default:
+			lastEventID = subscription.LastEventID
+			// Seeded before the snapshot is taken, resources removed in between are still held and their events sent
+			received.seed(resource.GetResources("", ""), match)
+			if err := writeSnapshot(w, lastEventID, getPayload(), fieldsList); err != nil {
+				fmt.Fprintf(w, "data: Error: %v\n\n", err)
+			}
+		}
+		flusher.Flush()
+
+		// Send the events until the request is done or the subscription is dropped for falling behind,
+		// then resume from the last event sent
+		for dropped := false; !dropped; {
+			select {
+			case <-r.Context().Done():
+				subscription.Unsubscribe()
+				return
+
+			case event, ok := <-subscription.Events:
+				if !ok {
+					dropped = true
+					continue
+				}
+				send(event)
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// sendDelta writes the event if the resource matches or the client received it before
+func sendDelta(w http.ResponseWriter, event resources.ResourceEvent, match func(*unstructured.Unstructured) bool, received *receivedUIDs, dense bool, fieldsList []string) {
+	delta, send := toDelta(event, match, received)
+	if !send {
+		return
+	}
+
+	if delta.Type != resources.Deleted {
+		object := event.Sparse
+		if dense {
+			object = event.Object
+		}
+		data, err := marshalObject(object, fieldsList)
+		if err != nil {
+			fmt.Fprintf(w, "data: Error: %v\n\n", err)
+			return
+		}
+		delta.Object = data
+	}
+
+	if err := writeEvent(w, event.ID, delta); err != nil {
+		fmt.Fprintf(w, "data: Error: %v\n\n", err)
+	}
+}
+
+// toDelta returns the delta to send for the event, if any, and records whether the client holds the resource after it
+func toDelta(event resources.ResourceEvent, match func(*unstructured.Unstructured) bool, received *receivedUIDs) (deltaEvent, bool) {
+	delta := deltaEvent{Type: event.Type, UID: event.UID}
+	if event.Type != resources.Deleted && match(event.Object) {
+		received.record(event.UID, true)
+		return delta, true
+	}
+
+	// A deleted resource, or one modified so that it no longer matches, is dropped by the client if it received it
+	held := received.held(event, match)
+	received.record(event.UID, false)
+	if !held {
+		return delta, false
+	}
+	delta.Type = resources.Deleted
+	return delta, true
+}
+
+// marshalObject marshals a single resource, filtering the fields if specified
+func marshalObject(object *unstructured.Unstructured, fieldsList []string) ([]byte, error) {
+	if len(fieldsList) > 0 {
+		return jsonMarshal(*object, fieldsList)
+	}
+	return jsonMarshal(object, nil)
+}
+
+// writeSnapshot writes the list as a snapshot event
+func writeSnapshot(w http.ResponseWriter, id string, payload any, fieldsList []string) error {
+	items, err := jsonMarshal(payload, fieldsList)
+	if err != nil {
+		return err
+	}
+	return writeEvent(w, id, snapshotEvent{Type: Snapshot, Items: items})
+}
+
+// writeEvent writes the payload as an SSE event with the given ID
+func writeEvent(w http.ResponseWriter, id string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	fmt.Fprintf(w, "id: %s\ndata: %s\n\n", id, data)
+	sseEvents.Inc()
+	return nil
+}
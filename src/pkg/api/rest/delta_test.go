@@ -0,0 +1,285 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/defenseunicorns/uds-runtime/src/test"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+// fakeInformer is a SharedIndexInformer whose events are sent by the test
+type fakeInformer struct {
+	cache.SharedIndexInformer
+	handler cache.ResourceEventHandler
+	indexer cache.Indexer
+}
+
+func (f *fakeInformer) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	f.indexer = cache.NewIndexer(cache.DeletionHandlingMetaNamespaceKeyFunc, cache.Indexers{})
+	f.handler = indexingHandler{indexer: f.indexer, handler: handler}
+	return nil, nil
+}
+
+func (f *fakeInformer) HasSynced() bool {
+	return true
+}
+
+func (f *fakeInformer) GetIndexer() cache.Indexer {
+	return f.indexer
+}
+
+// indexingHandler updates the indexer before calling the handler, as the informer does
+type indexingHandler struct {
+	indexer cache.Indexer
+	handler cache.ResourceEventHandler
+}
+
+func (h indexingHandler) OnAdd(obj interface{}, isInInitialList bool) {
+	//nolint:errcheck
+	h.indexer.Add(obj)
+	h.handler.OnAdd(obj, isInInitialList)
+}
+
+func (h indexingHandler) OnUpdate(oldObj, newObj interface{}) {
+	//nolint:errcheck
+	h.indexer.Update(newObj)
+	h.handler.OnUpdate(oldObj, newObj)
+}
+
+func (h indexingHandler) OnDelete(obj interface{}) {
+	//nolint:errcheck
+	h.indexer.Delete(obj)
+	h.handler.OnDelete(obj)
+}
+
+// sseEvent is a parsed SSE event, data is set if the event data is a JSON object
+type sseEvent struct {
+	id    string
+	event string
+	raw   string
+	data  map[string]interface{}
+}
+
+// readEvents parses the SSE events of the stream and sends them to the returned channel
+func readEvents(t *testing.T, resp *http.Response) <-chan sseEvent {
+	events := make(chan sseEvent, 10)
+	go func() {
+		defer close(events)
+		scanner := bufio.NewScanner(resp.Body)
+		var event sseEvent
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				event.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				event.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				event.raw = strings.TrimPrefix(line, "data: ")
+				if strings.HasPrefix(event.raw, "{") {
+					if err := json.Unmarshal([]byte(event.raw), &event.data); err != nil {
+						t.Errorf("invalid event data %q: %v", line, err)
+					}
+				}
+			case line == "":
+				events <- event
+				event = sseEvent{}
+			}
+		}
+	}()
+	return events
+}
+
+func nextEvent(t *testing.T, events <-chan sseEvent) sseEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for an event")
+		return sseEvent{}
+	}
+}
+
+func TestBindDeltas(t *testing.T) {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+
+	web := test.CreateMockPod("web", "default", "1")
+	web.SetLabels(map[string]string{"app": "web"})
+	web.SetResourceVersion("1")
+	web.Object["spec"] = map[string]interface{}{"nodeName": "node-1"}
+	informer.handler.OnAdd(web, true)
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/pods?mode=delta&labelSelector=app%3Dweb")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "text/event-stream; charset=utf-8", resp.Header.Get("Content-Type"))
+	events := readEvents(t, resp)
+
+	// The stream starts with a snapshot of the sparse list
+	event := nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-1"), event.id)
+	require.Equal(t, Snapshot, event.data["type"])
+	items := event.data["items"].([]interface{})
+	require.Len(t, items, 1)
+	require.NotContains(t, items[0], "spec")
+
+	// Matching resources are sent as they change
+	db := test.CreateMockPod("db", "default", "2")
+	db.SetLabels(map[string]string{"app": "db"})
+	informer.handler.OnAdd(db, false)
+
+	api := test.CreateMockPod("api", "default", "3")
+	api.SetLabels(map[string]string{"app": "web"})
+	informer.handler.OnAdd(api, false)
+
+	event = nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-3"), event.id)
+	require.Equal(t, resources.Added, event.data["type"])
+	require.Equal(t, "3", event.data["uid"])
+	require.Equal(t, "api", event.data["object"].(map[string]interface{})["metadata"].(map[string]interface{})["name"])
+
+	// Resources the client never received are not sent when they change or are deleted
+	modifiedDB := db.DeepCopy()
+	modifiedDB.SetResourceVersion("2")
+	informer.handler.OnUpdate(db, modifiedDB)
+	informer.handler.OnDelete(modifiedDB.DeepCopy())
+
+	// Resources modified so they no longer match are sent as deleted
+	relabeled := web.DeepCopy()
+	relabeled.SetLabels(map[string]string{"app": "db"})
+	relabeled.SetResourceVersion("2")
+	informer.handler.OnUpdate(web, relabeled)
+
+	event = nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-6"), event.id)
+	require.Equal(t, map[string]interface{}{"type": resources.Deleted, "uid": "1"}, event.data)
+
+	// Once dropped, they are not sent again until they match
+	deleted := relabeled.DeepCopy()
+	deleted.SetResourceVersion("3")
+	informer.handler.OnDelete(deleted)
+
+	informer.handler.OnDelete(api)
+	event = nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-8"), event.id)
+	require.Equal(t, map[string]interface{}{"type": resources.Deleted, "uid": "3"}, event.data)
+}
+
+func TestBindDeltasResume(t *testing.T) {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	informer.handler.OnAdd(test.CreateMockPod("web", "default", "1"), true)
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	connect := func(lastEventID string) (<-chan sseEvent, func()) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/pods?mode=delta&namespace=default", nil)
+		require.NoError(t, err)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return readEvents(t, resp), func() { resp.Body.Close() }
+	}
+
+	events, disconnect := connect("")
+	snapshot := nextEvent(t, events)
+	require.Equal(t, Snapshot, snapshot.data["type"])
+	disconnect()
+
+	// Changes made while disconnected are replayed on reconnect, without a snapshot
+	// Deletions are only sent for resources that matched before, so the client may have received them
+	informer.handler.OnAdd(test.CreateMockPod("db", "default", "2"), false)
+	informer.handler.OnAdd(test.CreateMockPod("other", "other", "3"), false)
+	informer.handler.OnDelete(test.CreateMockPod("other", "other", "3"))
+	informer.handler.OnDelete(test.CreateMockPod("web", "default", "1"))
+
+	events, disconnect = connect(snapshot.id)
+	defer disconnect()
+
+	event := nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-2"), event.id)
+	require.Equal(t, resources.Added, event.data["type"])
+	require.Equal(t, "2", event.data["uid"])
+
+	event = nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-5"), event.id)
+	require.Equal(t, map[string]interface{}{"type": resources.Deleted, "uid": "1"}, event.data)
+
+	// New changes follow the replayed ones
+	informer.handler.OnAdd(test.CreateMockPod("api", "default", "4"), false)
+	event = nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-6"), event.id)
+
+	// Unknown event IDs start over from a snapshot
+	events, disconnectUnknown := connect("unknown-1")
+	defer disconnectUnknown()
+	event = nextEvent(t, events)
+	require.Equal(t, Snapshot, event.data["type"])
+	require.True(t, strings.HasSuffix(event.id, "-6"), event.id)
+	require.Len(t, event.data["items"], 2)
+}
+
+func TestBindDeltasFields(t *testing.T) {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/pods?mode=delta&fields=.metadata.name,.spec.nodeName")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	events := readEvents(t, resp)
+
+	event := nextEvent(t, events)
+	require.True(t, strings.HasSuffix(event.id, "-0"), event.id)
+	require.Empty(t, event.data["items"])
+
+	pod := test.CreateMockPod("web", "default", "1")
+	pod.Object["spec"] = map[string]interface{}{"nodeName": "node-1", "hostNetwork": true}
+	informer.handler.OnAdd(pod, false)
+
+	event = nextEvent(t, events)
+	require.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "web"},
+		"spec":     map[string]interface{}{"nodeName": "node-1"},
+	}, event.data["object"])
+}
+
+func TestBindDeltasInvalid(t *testing.T) {
+	resourceList := resources.NewResourceList(&fakeInformer{}, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	resourceList.Resources["1"] = &unstructured.Unstructured{}
+
+	for _, url := range []string{"/pods?mode=deltas", "/pods?mode=delta&limit=10"} {
+		rr := httptest.NewRecorder()
+		Bind(resourceList)(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code, url)
+	}
+}
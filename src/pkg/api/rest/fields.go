@@ -0,0 +1,511 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Field expressions select parts of a resource for the fields query param, they are parsed as kubectl JSONPath
+// In addition to dotted paths with a [] array suffix, e.g. .spec.containers[].name, they support:
+//   - escaped keys, e.g. .metadata.labels.app\.kubernetes\.io/name or, as in kubectl, .metadata.labels['app\.kubernetes\.io/name']
+//   - array indexes, e.g. .spec.containers[0].image, negative indexes count from the end
+//   - wildcards, e.g. .spec.containers[*].image
+//   - filters, e.g. .status.conditions[?(@.type=="Ready")].status with ==, !=, <, <=, > and >=, or [?(@.key)] for existence
+//   - output aliases like kubectl custom-columns, e.g. ready:.status.conditions[?(@.type=="Ready")].status
+//   - kubectl JSONPath braces, e.g. {.metadata.name}
+//
+// Paths without an alias keep the structure of the resource, arrays only contain the selected elements
+// Aliased paths are set as top-level keys, to the selected value or, for wildcards and filters, a list of the selected values
+
+// aliasPattern matches the alias of an expression, e.g. NAME: in NAME:.metadata.name
+var aliasPattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_-]*):`)
+
+// segmentKind is the kind of a step in a field expression
+type segmentKind int
+
+const (
+	keySegment segmentKind = iota
+	indexSegment
+	wildcardSegment
+	filterSegment
+)
+
+// segment is a step in a field expression
+type segment struct {
+	kind   segmentKind
+	key    string
+	index  int
+	filter *fieldFilter
+}
+
+// fieldFilter selects the array elements where the value at path compares to value with operator
+// An empty operator selects the elements where the path exists
+type fieldFilter struct {
+	path     []segment
+	operator string
+	value    interface{}
+}
+
+// selection is a projected array, its elements are keyed by their index in the resource so that the selections of
+// several expressions from the same array are merged by element
+type selection map[int]interface{}
+
+// fieldExpression is a parsed field expression
+type fieldExpression struct {
+	alias    string
+	segments []segment
+	// multiple is whether the expression may select several values
+	multiple bool
+}
+
+// isLegacyFieldPath returns whether the path only uses dotted keys and the [] array suffix
+// On their own, these paths are handled by getNestedValueFromUnstructured and setNestedValue as they always were
+func isLegacyFieldPath(path string) bool {
+	return !strings.ContainsAny(strings.ReplaceAll(path, "[]", ""), `[]\{}:'"*?@`)
+}
+
+// legacySegments converts a dotted path with the [] array suffix to segments, so it can be merged with expressions
+func legacySegments(path string) []segment {
+	var segments []segment
+	for _, key := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		segments = append(segments, segment{kind: keySegment, key: strings.TrimSuffix(key, "[]")})
+		if strings.HasSuffix(key, "[]") {
+			segments = append(segments, segment{kind: wildcardSegment})
+		}
+	}
+	return segments
+}
+
+// splitFields splits the fields query param on the commas that are not part of a bracket or quoted string
+func splitFields(fields string) []string {
+	var result []string
+	var quote rune
+	depth, start := 0, 0
+
+	for i, c := range fields {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '(' || c == '{':
+			depth++
+		case c == ']' || c == ')' || c == '}':
+			depth--
+		case c == ',' && depth == 0:
+			result = append(result, fields[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(result, fields[start:])
+}
+
+// parseFieldExpression parses a field expression such as ready:.status.conditions[?(@.type=="Ready")].status
+// Paths are parsed by the kubectl JSONPath parser, its evaluator only returns the selected values and not where they are
+// in the resource, so the parsed nodes are converted to segments that project can rebuild the structure from
+func parseFieldExpression(expression string) (fieldExpression, error) {
+	var parsed fieldExpression
+
+	path := strings.TrimSpace(expression)
+	if match := aliasPattern.FindStringSubmatch(path); match != nil {
+		parsed.alias = match[1]
+		path = path[len(match[0]):]
+	}
+	if strings.Trim(path, "{.}") == "" {
+		return parsed, fmt.Errorf("invalid field %q: empty path", expression)
+	}
+
+	parser, err := jsonpath.Parse("fields", relaxedJSONPath(path))
+	if err != nil {
+		return parsed, fmt.Errorf("invalid field %q: %w", expression, err)
+	}
+	// A path is a single action, text around it or several actions are templates
+	if len(parser.Root.Nodes) != 1 || parser.Root.Nodes[0].Type() != jsonpath.NodeList {
+		return parsed, fmt.Errorf("invalid field %q: expected a single path", expression)
+	}
+
+	segments, err := toSegments(parser.Root.Nodes[0].(*jsonpath.ListNode))
+	if err != nil {
+		return parsed, fmt.Errorf("invalid field %q: %w", expression, err)
+	}
+
+	parsed.segments = segments
+	for _, s := range segments {
+		if s.kind == wildcardSegment || s.kind == filterSegment {
+			parsed.multiple = true
+		}
+	}
+	return parsed, nil
+}
+
+// toSegments converts the nodes of a parsed path to segments
+// Unions, slices, recursive descent and map wildcards are not supported as their selection can't be projected by position
+func toSegments(list *jsonpath.ListNode) ([]segment, error) {
+	var segments []segment
+	for _, node := range list.Nodes {
+		switch node := node.(type) {
+		case *jsonpath.FieldNode:
+			// The parser adds empty fields for a leading dot or @
+			if node.Value != "" {
+				segments = append(segments, segment{kind: keySegment, key: node.Value})
+			}
+
+		case *jsonpath.ArrayNode:
+			s, err := toArraySegment(node.Params)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, s)
+
+		case *jsonpath.FilterNode:
+			filter, err := toFilter(node)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment{kind: filterSegment, filter: filter})
+
+		default:
+			return nil, fmt.Errorf("unsupported %s", node)
+		}
+	}
+	return segments, nil
+}
+
+// toArraySegment converts the start, end and step of an array node to an index or wildcard segment
+func toArraySegment(params [3]jsonpath.ParamsEntry) (segment, error) {
+	start, end, step := params[0], params[1], params[2]
+	switch {
+	case !step.Known && start.Known && end.Derived:
+		return segment{kind: indexSegment, index: start.Value}, nil
+	// [] is parsed as an end derived without a start, [*] and [:] as neither a start nor an end
+	case !step.Known && !start.Known && (end.Derived || !end.Known):
+		return segment{kind: wildcardSegment}, nil
+	default:
+		return segment{}, fmt.Errorf("unsupported array slice")
+	}
+}
+
+// toFilter converts a filter node, its left side is a path relative to the element and its right side a literal
+func toFilter(node *jsonpath.FilterNode) (*fieldFilter, error) {
+	path, err := toSegments(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	filter := &fieldFilter{path: path}
+
+	switch node.Operator {
+	case "exists":
+		return filter, nil
+	case "==", "!=", "<", "<=", ">", ">=":
+		filter.operator = node.Operator
+	default:
+		return nil, fmt.Errorf("unsupported filter operator %q", node.Operator)
+	}
+
+	if len(node.Right.Nodes) != 1 {
+		return nil, fmt.Errorf("filter value must be a single literal")
+	}
+	switch value := node.Right.Nodes[0].(type) {
+	case *jsonpath.TextNode:
+		filter.value = value.Text
+	case *jsonpath.IntNode:
+		filter.value = float64(value.Value)
+	case *jsonpath.FloatNode:
+		filter.value = value.Value
+	case *jsonpath.BoolNode:
+		filter.value = value.Value
+	case *jsonpath.IdentifierNode:
+		if value.Name != "null" {
+			return nil, fmt.Errorf("invalid filter value %q", value.Name)
+		}
+	default:
+		return nil, fmt.Errorf("invalid filter value %s", value)
+	}
+	return filter, nil
+}
+
+// project returns the parts of the value selected by the segments, keeping their structure
+func project(value interface{}, segments []segment) (interface{}, bool) {
+	if len(segments) == 0 {
+		return deepCopy(value), true
+	}
+	s, rest := segments[0], segments[1:]
+
+	if s.kind == keySegment {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		child, exists := object[s.key]
+		if !exists {
+			return nil, false
+		}
+		projected, found := project(child, rest)
+		if !found {
+			return nil, false
+		}
+		return map[string]interface{}{s.key: projected}, true
+	}
+
+	array, indexes, ok := selectIndexes(value, s)
+	if !ok {
+		return nil, false
+	}
+
+	projected := selection{}
+	for _, i := range indexes {
+		if len(rest) == 0 {
+			projected[i] = deepCopy(array[i])
+		} else if child, found := project(array[i], rest); found {
+			projected[i] = child
+		}
+	}
+	// Like the [] suffix, selecting whole elements keeps the selection even if it is empty
+	if len(projected) == 0 && len(rest) > 0 {
+		return nil, false
+	}
+	return projected, true
+}
+
+// extract returns the values selected by the segments
+func extract(value interface{}, segments []segment) []interface{} {
+	if len(segments) == 0 {
+		return []interface{}{deepCopy(value)}
+	}
+	s, rest := segments[0], segments[1:]
+
+	if s.kind == keySegment {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		child, exists := object[s.key]
+		if !exists {
+			return nil
+		}
+		return extract(child, rest)
+	}
+
+	array, indexes, _ := selectIndexes(value, s)
+	var values []interface{}
+	for _, i := range indexes {
+		values = append(values, extract(array[i], rest)...)
+	}
+	return values
+}
+
+// selectIndexes returns the array and the indexes of its elements selected by an index, wildcard or filter segment
+func selectIndexes(value interface{}, s segment) ([]interface{}, []int, bool) {
+	array, ok := value.([]interface{})
+	if !ok {
+		return nil, nil, false
+	}
+
+	switch s.kind {
+	case indexSegment:
+		index := s.index
+		if index < 0 {
+			index += len(array)
+		}
+		if index < 0 || index >= len(array) {
+			return nil, nil, false
+		}
+		return array, []int{index}, true
+
+	case filterSegment:
+		selected := []int{}
+		for i, element := range array {
+			if s.filter.matches(element) {
+				selected = append(selected, i)
+			}
+		}
+		return array, selected, true
+
+	default:
+		selected := make([]int, len(array))
+		for i := range array {
+			selected[i] = i
+		}
+		return array, selected, true
+	}
+}
+
+// matches returns whether the element is selected by the filter
+func (f *fieldFilter) matches(element interface{}) bool {
+	values := extract(element, f.path)
+	if len(values) == 0 {
+		return false
+	}
+	if f.operator == "" {
+		return true
+	}
+
+	value := values[0]
+	if valueKind(value) != valueKind(f.value) {
+		return f.operator == "!="
+	}
+	result := compareValues(value, f.value)
+
+	switch f.operator {
+	case "==":
+		return result == 0
+	case "!=":
+		return result != 0
+	}
+
+	// Ordering is only defined for numbers and strings
+	if kind := valueKind(value); kind != "number" && kind != "string" {
+		return false
+	}
+	switch f.operator {
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	default:
+		return result >= 0
+	}
+}
+
+// valueKind returns the JSON kind of a value, so filters only compare values of the same kind
+func valueKind(value interface{}) string {
+	if _, ok := toFloat(value); ok {
+		return "number"
+	}
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	default:
+		return "other"
+	}
+}
+
+// deepCopy copies the maps and arrays of a value, so filtered items never share them with the cached resources
+func deepCopy(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(value))
+		for key, child := range value {
+			copied[key] = deepCopy(child)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(value))
+		for i, child := range value {
+			copied[i] = deepCopy(child)
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+// apply sets the selected parts of the object in the filtered item
+func (e fieldExpression) apply(object map[string]interface{}, filtered map[string]interface{}) {
+	if e.alias != "" {
+		values := extract(object, e.segments)
+		switch {
+		case e.multiple:
+			if values == nil {
+				values = []interface{}{}
+			}
+			filtered[e.alias] = values
+		case len(values) > 0:
+			filtered[e.alias] = values[0]
+		}
+		return
+	}
+
+	if projected, found := project(object, e.segments); found {
+		mergeProjection(filtered, projected.(map[string]interface{}))
+	}
+}
+
+// mergeProjection merges the projected fields into the filtered item, array elements are merged by their index in the resource
+func mergeProjection(filtered map[string]interface{}, projected map[string]interface{}) {
+	for key, value := range projected {
+		filtered[key] = mergeValue(filtered[key], value)
+	}
+}
+
+// mergeValue merges a projected value into an existing one
+func mergeValue(existing, value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if existingMap, ok := existing.(map[string]interface{}); ok {
+			mergeProjection(existingMap, value)
+			return existingMap
+		}
+	case selection, []interface{}:
+		// A whole array is a selection of all its elements
+		if existingSelection, ok := toSelection(existing); ok {
+			valueSelection, _ := toSelection(value)
+			for i, element := range valueSelection {
+				existingSelection[i] = mergeValue(existingSelection[i], element)
+			}
+			return existingSelection
+		}
+	}
+	return value
+}
+
+// toSelection returns the elements of a selection or an array keyed by their index
+func toSelection(value interface{}) (selection, bool) {
+	switch value := value.(type) {
+	case selection:
+		return value, true
+	case []interface{}:
+		selected := make(selection, len(value))
+		for i, element := range value {
+			selected[i] = element
+		}
+		return selected, true
+	default:
+		return nil, false
+	}
+}
+
+// arrange replaces the selections in a filtered value by arrays of the selected elements in the order of the resource
+func arrange(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			value[key] = arrange(child)
+		}
+		return value
+	case []interface{}:
+		for i, child := range value {
+			value[i] = arrange(child)
+		}
+		return value
+	case selection:
+		indexes := make([]int, 0, len(value))
+		for i := range value {
+			indexes = append(indexes, i)
+		}
+		sort.Ints(indexes)
+		elements := make([]interface{}, len(indexes))
+		for i, index := range indexes {
+			elements[i] = arrange(value[index])
+		}
+		return elements
+	default:
+		return value
+	}
+}
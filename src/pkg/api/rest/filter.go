@@ -4,6 +4,7 @@
 package rest
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -11,11 +12,25 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+// cachedPayload is a list or page of resources whose JSON encodings may be cached by their ResourceList
+type cachedPayload struct {
+	payload    any
+	cachedJSON func([]unstructured.Unstructured) [][]byte
+}
+
 // jsonMarshal marshals the payload to JSON and filters the fields if specified
 func jsonMarshal(payload any, fieldsList []string) ([]byte, error) {
 	var data []byte
 	var err error
 
+	// Build lists from the cached encodings of their resources, unless the fields need to be filtered
+	if cached, ok := payload.(cachedPayload); ok {
+		if len(fieldsList) > 0 {
+			return jsonMarshal(cached.payload, fieldsList)
+		}
+		return cached.marshal()
+	}
+
 	// If fields are specified, filter the payload based on the fields
 	if len(fieldsList) > 0 {
 		// Check the type of the payload and filter the fields accordingly
@@ -30,6 +45,13 @@ func jsonMarshal(payload any, fieldsList []string) ([]byte, error) {
 			filteredItems := filterItemsByFields(payload, fieldsList)
 			data, err = json.Marshal(filteredItems)
 
+		// Handle a page of resources
+		case ListPage:
+			data, err = json.Marshal(struct {
+				Items    []map[string]interface{} `json:"items"`
+				Metadata ListMetadata             `json:"metadata"`
+			}{filterItemsByFields(payload.Items, fieldsList), payload.Metadata})
+
 		default:
 			return nil, fmt.Errorf("invalid data type: %T", payload)
 		}
@@ -51,25 +73,57 @@ func jsonMarshal(payload any, fieldsList []string) ([]byte, error) {
 func filterItemsByFields(items []unstructured.Unstructured, fieldPaths []string) []map[string]interface{} {
 	var filtered []map[string]interface{}
 
+	// Parse the field expressions once, dotted paths are handled as they always were unless they are combined with
+	// expressions, then they are projected like expressions so that elements of the same array are merged by index
+	hasExpressions := false
+	for _, fieldPath := range fieldPaths {
+		if !isLegacyFieldPath(fieldPath) {
+			hasExpressions = true
+		}
+	}
+	expressions := make([]*fieldExpression, len(fieldPaths))
+	for i, fieldPath := range fieldPaths {
+		switch {
+		case !isLegacyFieldPath(fieldPath):
+			// Invalid expressions are rejected with the request, skip them here
+			if expression, err := parseFieldExpression(fieldPath); err == nil {
+				expressions[i] = &expression
+			}
+		case hasExpressions:
+			expressions[i] = &fieldExpression{segments: legacySegments(fieldPath)}
+		}
+	}
+
 	// Iterate over each item and filter the fields
 	for _, item := range items {
 		itemData := map[string]interface{}{}
 
 		// Process each field path
-		for _, fieldPath := range fieldPaths {
+		for i, fieldPath := range fieldPaths {
+			if expressions[i] != nil {
+				expressions[i].apply(item.Object, itemData)
+				continue
+			}
+			if !isLegacyFieldPath(fieldPath) {
+				continue
+			}
+
 			// Remove the leading dot and split the path into keys
 			keys := strings.Split(strings.TrimPrefix(fieldPath, "."), ".")
 			// Get the value for this field path
 			value, found := getNestedValueFromUnstructured(item.Object, keys)
 			if found {
-				// If the value is found, set it in the filtered item data
-				setNestedValue(itemData, keys, value)
+				// If the value is found, set a copy of it in the filtered item data so the cached resource is never modified
+				setNestedValue(itemData, keys, deepCopy(value))
 			}
 		}
+		if hasExpressions {
+			arrange(itemData)
+		}
 
 		// Delete managedFields from .metadata if it exists
-		if itemData["metadata"] != nil {
-			delete(itemData["metadata"].(map[string]interface{}), "managedFields")
+		if metadata, ok := itemData["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "managedFields")
 		}
 
 		// Add the filtered item to the result
@@ -243,3 +297,67 @@ func setNestedValue(obj map[string]interface{}, keys []string, value interface{}
 		setNestedValue(obj[key].(map[string]interface{}), keys[1:], value)
 	}
 }
+
+// marshal encodes the payload, concatenating the cached encodings of its resources
+func (c cachedPayload) marshal() ([]byte, error) {
+	switch payload := c.payload.(type) {
+	case []unstructured.Unstructured:
+		return marshalItems(payload, c.cachedJSON(payload))
+
+	case ListPage:
+		items, err := marshalItems(payload.Items, c.cachedJSON(payload.Items))
+		if err != nil {
+			return nil, err
+		}
+		metadata, err := json.Marshal(payload.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal data: %w", err)
+		}
+
+		var buf bytes.Buffer
+		buf.Grow(len(items) + len(metadata) + 24)
+		buf.WriteString(`{"items":`)
+		buf.Write(items)
+		buf.WriteString(`,"metadata":`)
+		buf.Write(metadata)
+		buf.WriteString(`}`)
+		return buf.Bytes(), nil
+
+	default:
+		return jsonMarshal(payload, nil)
+	}
+}
+
+// marshalItems encodes the items as a JSON array, using the given encoding of each item if it is not nil
+func marshalItems(items []unstructured.Unstructured, encodings [][]byte) ([]byte, error) {
+	// Match json.Marshal for nil slices
+	if items == nil {
+		return []byte("null"), nil
+	}
+
+	size := len(items) + 1
+	for _, encoded := range encodings {
+		size += len(encoded)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(size)
+	buf.WriteByte('[')
+	for i := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		encoded := encodings[i]
+		if encoded == nil {
+			var err error
+			if encoded, err = json.Marshal(&items[i]); err != nil {
+				return nil, fmt.Errorf("failed to marshal data: %w", err)
+			}
+		}
+		buf.Write(encoded)
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
@@ -4,10 +4,15 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestJsonMarshal(t *testing.T) {
@@ -259,6 +264,380 @@ func TestFilterItemsByFields(t *testing.T) {
 	}
 }
 
+func fieldsTestPod() unstructured.Unstructured {
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": "test-pod",
+				"labels": map[string]interface{}{
+					"app":                    "web",
+					"app.kubernetes.io/name": "frontend",
+					"tier-name":              "edge",
+				},
+				"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx", "ports": []interface{}{map[string]interface{}{"containerPort": int64(80)}}},
+					map[string]interface{}{"name": "sidecar", "image": "envoy", "ports": []interface{}{map[string]interface{}{"containerPort": int64(9901)}}},
+				},
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Initialized", "status": "True"},
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "ContainersNotReady"},
+				},
+			},
+		},
+	}
+}
+
+// TestFilterItemsByFieldsLegacySyntax checks that dotted paths with the [] suffix select the same fields as before field
+// expressions were supported, including paths that do not select anything
+func TestFilterItemsByFieldsLegacySyntax(t *testing.T) {
+	tests := []struct {
+		name       string
+		fieldPaths []string
+		want       map[string]interface{}
+	}{
+		{
+			name:       "Path without a leading dot",
+			fieldPaths: []string{"metadata.name"},
+			want:       map[string]interface{}{"metadata": map[string]interface{}{"name": "test-pod"}},
+		},
+		{
+			name:       "Key with a hyphen",
+			fieldPaths: []string{".metadata.labels.tier-name"},
+			want:       map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"tier-name": "edge"}}},
+		},
+		{
+			name:       "Whole object without managedFields",
+			fieldPaths: []string{".metadata"},
+			want: map[string]interface{}{"metadata": map[string]interface{}{
+				"name":   "test-pod",
+				"labels": map[string]interface{}{"app": "web", "app.kubernetes.io/name": "frontend", "tier-name": "edge"},
+			}},
+		},
+		{
+			name:       "Whole array",
+			fieldPaths: []string{".status.conditions[]"},
+			want: map[string]interface{}{"status": map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Initialized", "status": "True"},
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "ContainersNotReady"},
+			}}},
+		},
+		{
+			name:       "Nested arrays",
+			fieldPaths: []string{".spec.containers[].ports[].containerPort"},
+			want: map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+				map[string]interface{}{"ports": []interface{}{map[string]interface{}{"containerPort": int64(80)}}},
+				map[string]interface{}{"ports": []interface{}{map[string]interface{}{"containerPort": int64(9901)}}},
+			}}},
+		},
+		{
+			name:       "Elements without the field are skipped",
+			fieldPaths: []string{".status.conditions[].reason"},
+			want: map[string]interface{}{"status": map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"reason": "ContainersNotReady"},
+			}}},
+		},
+		{
+			name:       "Array fields merged by position",
+			fieldPaths: []string{".spec.containers[].name", ".spec.containers[].image"},
+			want: map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx"},
+				map[string]interface{}{"name": "sidecar", "image": "envoy"},
+			}}},
+		},
+		{
+			name:       "Dots always separate keys",
+			fieldPaths: []string{".metadata.labels.app.kubernetes.io/name"},
+			want:       map[string]interface{}{},
+		},
+		{
+			name:       "Array without the [] suffix",
+			fieldPaths: []string{".spec.containers.name"},
+			want:       map[string]interface{}{},
+		},
+		{
+			name:       "[] suffix on a map",
+			fieldPaths: []string{".metadata.labels[]"},
+			want:       map[string]interface{}{},
+		},
+		{
+			name:       "[] suffix on a value",
+			fieldPaths: []string{".metadata.name[]"},
+			want:       map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, fieldPath := range tt.fieldPaths {
+				require.True(t, isLegacyFieldPath(fieldPath), fieldPath)
+			}
+			pod := fieldsTestPod()
+			got := filterItemsByFields([]unstructured.Unstructured{pod}, tt.fieldPaths)
+			require.Equal(t, []map[string]interface{}{tt.want}, got)
+			require.Equal(t, fieldsTestPod(), pod)
+		})
+	}
+}
+
+func TestFilterItemsByFieldExpressions(t *testing.T) {
+	tests := []struct {
+		name       string
+		fieldPaths []string
+		want       map[string]interface{}
+	}{
+		{
+			name:       "Escaped key",
+			fieldPaths: []string{`.metadata.labels.app\.kubernetes\.io/name`},
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app.kubernetes.io/name": "frontend"}},
+			},
+		},
+		{
+			name:       "Bracket key",
+			fieldPaths: []string{`.metadata.labels['app\.kubernetes\.io/name']`, ".metadata.labels['app']"},
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app.kubernetes.io/name": "frontend", "app": "web"}},
+			},
+		},
+		{
+			name:       "Index",
+			fieldPaths: []string{".spec.containers[0].image"},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"image": "nginx"}}},
+			},
+		},
+		{
+			name:       "Negative index",
+			fieldPaths: []string{".spec.containers[-1].name"},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "sidecar"}}},
+			},
+		},
+		{
+			name:       "Index out of range",
+			fieldPaths: []string{".spec.containers[2].name"},
+			want:       map[string]interface{}{},
+		},
+		{
+			name:       "Wildcard merged with another path",
+			fieldPaths: []string{".spec.containers[*].name", ".spec.containers[*].image"},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx"},
+					map[string]interface{}{"name": "sidecar", "image": "envoy"},
+				}},
+			},
+		},
+		{
+			name:       "Filter by equality",
+			fieldPaths: []string{`.status.conditions[?(@.type=="Ready")].status`},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"status": "False"}}},
+			},
+		},
+		{
+			name:       "Filter whole elements",
+			fieldPaths: []string{".status.conditions[?(@.type != 'Ready')]"},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Initialized", "status": "True"}}},
+			},
+		},
+		{
+			name:       "Filter without matches keeps an empty list",
+			fieldPaths: []string{`.status.conditions[?(@.type=="Scheduled")]`},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{}},
+			},
+		},
+		{
+			name:       "Filter by existence",
+			fieldPaths: []string{".status.conditions[?(@.reason)].type"},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Ready"}}},
+			},
+		},
+		{
+			name:       "Filter by numeric comparison on a nested path",
+			fieldPaths: []string{".spec.containers[?(@.ports[0].containerPort > 1024)].name"},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "sidecar"}}},
+			},
+		},
+		{
+			name:       "Filter does not compare values of different kinds",
+			fieldPaths: []string{`.spec.containers[?(@.ports[0].containerPort == "80")].name`},
+			want:       map[string]interface{}{},
+		},
+		{
+			name:       "Aliases",
+			fieldPaths: []string{"name:.metadata.name", `ready:.status.conditions[?(@.type=="Ready")].status`, "images:.spec.containers[*].image", "missing:.spec.nodeName"},
+			want: map[string]interface{}{
+				"name":   "test-pod",
+				"ready":  []interface{}{"False"},
+				"images": []interface{}{"nginx", "envoy"},
+			},
+		},
+		{
+			name:       "Alias without matches is an empty list",
+			fieldPaths: []string{`ready:.status.conditions[?(@.type=="Scheduled")].status`},
+			want:       map[string]interface{}{"ready": []interface{}{}},
+		},
+		{
+			name:       "Braces",
+			fieldPaths: []string{"{.metadata.name}", "image:{.spec.containers[0].image}"},
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test-pod"},
+				"image":    "nginx",
+			},
+		},
+		{
+			name:       "Dotted paths mixed with expressions",
+			fieldPaths: []string{".metadata.name", ".metadata.labels['app']", ".spec.containers[].name"},
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test-pod", "labels": map[string]interface{}{"app": "web"}},
+				"spec": map[string]interface{}{"containers": []interface{}{
+					map[string]interface{}{"name": "app"},
+					map[string]interface{}{"name": "sidecar"},
+				}},
+			},
+		},
+		{
+			name:       "Index merged with another index of the same array",
+			fieldPaths: []string{".spec.containers[1].image", ".spec.containers[0].name"},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{
+					map[string]interface{}{"name": "app"},
+					map[string]interface{}{"image": "envoy"},
+				}},
+			},
+		},
+		{
+			name:       "Filter merged with a dotted path into the same array",
+			fieldPaths: []string{`.status.conditions[?(@.type=="Ready")].status`, ".status.conditions[].type"},
+			want: map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{
+					map[string]interface{}{"type": "Initialized"},
+					map[string]interface{}{"type": "Ready", "status": "False"},
+				}},
+			},
+		},
+		{
+			name:       "Index merged with a wildcard",
+			fieldPaths: []string{".spec.containers[-1].ports[0].containerPort", ".spec.containers[*].name"},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{
+					map[string]interface{}{"name": "app"},
+					map[string]interface{}{"name": "sidecar", "ports": []interface{}{map[string]interface{}{"containerPort": int64(9901)}}},
+				}},
+			},
+		},
+		{
+			name:       "Index merged with the whole array",
+			fieldPaths: []string{".spec.containers[1].name", ".status.conditions", ".status.conditions[?(@.reason)].reason"},
+			want: map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "sidecar"}}},
+				"status": map[string]interface{}{"conditions": []interface{}{
+					map[string]interface{}{"type": "Initialized", "status": "True"},
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "ContainersNotReady"},
+				}},
+			},
+		},
+		{
+			name:       "Filter without matches",
+			fieldPaths: []string{`.status.conditions[?(@.type=="Unknown")]`, ".metadata.name"},
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test-pod"},
+				"status":   map[string]interface{}{"conditions": []interface{}{}},
+			},
+		},
+		{
+			name:       "Invalid expressions are skipped",
+			fieldPaths: []string{".metadata.name", ".spec.containers[abc]"},
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test-pod"},
+			},
+		},
+		{
+			name:       "managedFields is removed",
+			fieldPaths: []string{".metadata['managedFields']", ".metadata.name"},
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "test-pod"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := fieldsTestPod()
+			got := filterItemsByFields([]unstructured.Unstructured{pod}, tt.fieldPaths)
+			require.Equal(t, []map[string]interface{}{tt.want}, got)
+			// The source resource is never modified
+			require.Equal(t, fieldsTestPod(), pod)
+		})
+	}
+}
+
+func TestFilterItemsByFieldsDoesNotModifyItems(t *testing.T) {
+	pod := fieldsTestPod()
+	filterItemsByFields([]unstructured.Unstructured{pod}, []string{".metadata", ".metadata.labels", ".metadata.labels['extra']"})
+	require.Equal(t, fieldsTestPod(), pod)
+}
+
+func TestSplitFields(t *testing.T) {
+	tests := []struct {
+		fields string
+		want   []string
+	}{
+		{".metadata.name,.status", []string{".metadata.name", ".status"}},
+		{".metadata.labels['a,b'],.status", []string{".metadata.labels['a,b']", ".status"}},
+		{`.status.conditions[?(@.type=="a,b")].status,name:.metadata.name`, []string{`.status.conditions[?(@.type=="a,b")].status`, "name:.metadata.name"}},
+		{"{.metadata.name},.spec", []string{"{.metadata.name}", ".spec"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fields, func(t *testing.T) {
+			require.Equal(t, tt.want, splitFields(tt.fields))
+		})
+	}
+}
+
+func TestParseFieldExpressionInvalid(t *testing.T) {
+	tests := []string{
+		"name:",
+		".spec.containers[abc]",
+		".spec.containers[0",
+		".metadata.labels['app",
+		".status.conditions[?(type=='Ready')]",
+		".status.conditions[?(@.type=='Ready']",
+		".status.conditions[?(@.count > ten)]",
+		".spec.containers[0:2].name",
+		".spec.containers[0,1].name",
+		"..name",
+		".metadata.labels.*",
+		"{.metadata.name}{.metadata.namespace}",
+	}
+
+	for _, expression := range tests {
+		t.Run(expression, func(t *testing.T) {
+			_, err := parseFieldExpression(expression)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestIsLegacyFieldPath(t *testing.T) {
+	require.True(t, isLegacyFieldPath(".metadata.name"))
+	require.True(t, isLegacyFieldPath(".spec.containers[].name"))
+	require.False(t, isLegacyFieldPath(".spec.containers[0].name"))
+	require.False(t, isLegacyFieldPath(`.metadata.labels.app\.kubernetes\.io/name`))
+	require.False(t, isLegacyFieldPath("name:.metadata.name"))
+}
+
 func TestGetNestedValueFromUnstructured(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -507,3 +886,160 @@ func TestSetNestedValue(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONMarshalCached(t *testing.T) {
+	items := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"kind": "Pod", "metadata": map[string]interface{}{"name": "pod1"}}},
+		{Object: map[string]interface{}{"kind": "Pod", "metadata": map[string]interface{}{"name": "pod2"}}},
+	}
+	// Only the first item is cached, with an encoding that differs so it is recognizable
+	cachedJSON := func(items []unstructured.Unstructured) [][]byte {
+		encodings := make([][]byte, len(items))
+		for i, item := range items {
+			if item.GetName() == "pod1" {
+				encodings[i] = []byte(`{"cached":true}`)
+			}
+		}
+		return encodings
+	}
+
+	tests := []struct {
+		name       string
+		payload    any
+		fieldsList []string
+		want       string
+	}{
+		{
+			name:    "List",
+			payload: items,
+			want:    `[{"cached":true},{"kind":"Pod","metadata":{"name":"pod2"}}]`,
+		},
+		{
+			name:    "Empty list",
+			payload: []unstructured.Unstructured{},
+			want:    `[]`,
+		},
+		{
+			name:    "Nil list",
+			payload: []unstructured.Unstructured(nil),
+			want:    `null`,
+		},
+		{
+			name:    "Page",
+			payload: ListPage{Items: items[1:], Metadata: ListMetadata{Total: 2}},
+			want:    `{"items":[{"kind":"Pod","metadata":{"name":"pod2"}}],"metadata":{"total":2}}`,
+		},
+		{
+			name:       "Fields are filtered from the resources",
+			payload:    items,
+			fieldsList: []string{".metadata.name"},
+			want:       `[{"metadata":{"name":"pod1"}},{"metadata":{"name":"pod2"}}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := jsonMarshal(cachedPayload{payload: tt.payload, cachedJSON: cachedJSON}, tt.fieldsList)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(data))
+		})
+	}
+}
+
+// benchmarkPods returns a list of pods fed through an informer so their encodings are cached
+func benchmarkPods(count int) *resources.ResourceList {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("pod-%d", i)
+		informer.handler.OnAdd(&unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":              name,
+				"namespace":         "default",
+				"uid":               fmt.Sprintf("uid-%d", i),
+				"resourceVersion":   "1",
+				"creationTimestamp": "2024-10-01T00:00:00Z",
+				"labels":            map[string]interface{}{"app": "web", "pod-template-hash": "5d8f7c9b4"},
+				"annotations":       map[string]interface{}{"kubectl.kubernetes.io/restartedAt": "2024-10-01T00:00:00Z"},
+			},
+			"spec": map[string]interface{}{
+				"nodeName": "node-1",
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":      "app",
+						"image":     "ghcr.io/defenseunicorns/app:1.0.0",
+						"ports":     []interface{}{map[string]interface{}{"containerPort": int64(8080), "protocol": "TCP"}},
+						"resources": map[string]interface{}{"requests": map[string]interface{}{"cpu": "100m", "memory": "128Mi"}},
+					},
+				},
+			},
+			"status": map[string]interface{}{
+				"phase":  "Running",
+				"podIP":  "10.0.0.1",
+				"hostIP": "172.18.0.2",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "lastTransitionTime": "2024-10-01T00:00:00Z"},
+				},
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"name": "app", "ready": true, "restartCount": int64(0), "started": true},
+				},
+			},
+		}}, true)
+	}
+
+	return resourceList
+}
+
+func BenchmarkJSONMarshal(b *testing.B) {
+	for _, count := range []int{1000, 10000} {
+		resourceList := benchmarkPods(count)
+
+		for _, sparse := range []bool{false, true} {
+			items := resourceList.GetResources("", "")
+			name := "dense"
+			if sparse {
+				items = resourceList.GetSparseResources("", "")
+				name = "sparse"
+			}
+			cached := cachedPayload{payload: items, cachedJSON: func(items []unstructured.Unstructured) [][]byte {
+				return resourceList.CachedJSON(items, sparse)
+			}}
+
+			b.Run(fmt.Sprintf("%d/%s/marshal", count, name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if _, err := jsonMarshal(items, nil); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+
+			b.Run(fmt.Sprintf("%d/%s/cached", count, name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if _, err := jsonMarshal(cached, nil); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestJSONMarshalCachedMatchesMarshal(t *testing.T) {
+	resourceList := benchmarkPods(20)
+
+	for _, sparse := range []bool{false, true} {
+		items := resourceList.GetResources("", "")
+		if sparse {
+			items = resourceList.GetSparseResources("", "")
+		}
+
+		expected, err := json.Marshal(items)
+		require.NoError(t, err)
+		data, err := jsonMarshal(cachedPayload{payload: items, cachedJSON: func(items []unstructured.Unstructured) [][]byte {
+			return resourceList.CachedJSON(items, sparse)
+		}}, nil)
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(data))
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/go-chi/chi/v5"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// RestartedAtAnnotation is the pod template annotation kubectl uses to trigger a rollout restart
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// MutationResponse is the JSON body returned by every mutation endpoint
+type MutationResponse struct {
+	DryRun bool `json:"dryRun"`
+	// Result is the object or status returned by the API server on success
+	Result any `json:"result,omitempty"`
+	// Error is the status returned by the API server on failure
+	Error *metaV1.Status `json:"error,omitempty"`
+}
+
+// mutation applies a change to a single resource through the dynamic client
+type mutation func(ctx context.Context, client dynamic.ResourceInterface, obj unstructured.Unstructured, dryRun []string) (any, error)
+
+// BindDelete deletes a resource, looked up by UID
+func BindDelete(resource *resources.ResourceList, dynamicClient dynamic.Interface, mapper meta.RESTMapper) func(w http.ResponseWriter, r *http.Request) {
+	return bindMutation(resource, dynamicClient, mapper, func(ctx context.Context, client dynamic.ResourceInterface, obj unstructured.Unstructured, dryRun []string) (any, error) {
+		// Guard against deleting a newer object that has reused the name
+		uid := obj.GetUID()
+		err := client.Delete(ctx, obj.GetName(), metaV1.DeleteOptions{
+			DryRun:        dryRun,
+			Preconditions: &metaV1.Preconditions{UID: &uid},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &metaV1.Status{
+			TypeMeta: metaV1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+			Status:   metaV1.StatusSuccess,
+			Details: &metaV1.StatusDetails{
+				Name:  obj.GetName(),
+				Group: obj.GroupVersionKind().Group,
+				Kind:  obj.GetKind(),
+				UID:   uid,
+			},
+		}, nil
+	})
+}
+
+// BindRestart triggers a rollout restart of a Deployment, StatefulSet or DaemonSet, looked up by UID
+func BindRestart(resource *resources.ResourceList, dynamicClient dynamic.Interface, mapper meta.RESTMapper) func(w http.ResponseWriter, r *http.Request) {
+	return bindMutation(resource, dynamicClient, mapper, func(ctx context.Context, client dynamic.ResourceInterface, obj unstructured.Unstructured, dryRun []string) (any, error) {
+		patch := map[string]any{
+			"spec": map[string]any{
+				"template": map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]string{
+							RestartedAtAnnotation: time.Now().Format(time.RFC3339),
+						},
+					},
+				},
+			},
+		}
+		return patchResource(ctx, client, obj.GetName(), types.StrategicMergePatchType, patch, dryRun)
+	})
+}
+
+// BindScale sets the replicas of a Deployment or StatefulSet, looked up by UID, from the replicas query param
+func BindScale(resource *resources.ResourceList, dynamicClient dynamic.Interface, mapper meta.RESTMapper) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		replicas, err := strconv.ParseInt(r.URL.Query().Get("replicas"), 10, 32)
+		if err != nil || replicas < 0 {
+			http.Error(w, "replicas must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+
+		bindMutation(resource, dynamicClient, mapper, func(ctx context.Context, client dynamic.ResourceInterface, obj unstructured.Unstructured, dryRun []string) (any, error) {
+			patch := map[string]any{"spec": map[string]any{"replicas": replicas}}
+			return patchResource(ctx, client, obj.GetName(), types.MergePatchType, patch, dryRun)
+		})(w, r)
+	}
+}
+
+// BindSuspend suspends or resumes a CronJob, looked up by UID
+func BindSuspend(resource *resources.ResourceList, dynamicClient dynamic.Interface, mapper meta.RESTMapper, suspend bool) func(w http.ResponseWriter, r *http.Request) {
+	return bindMutation(resource, dynamicClient, mapper, func(ctx context.Context, client dynamic.ResourceInterface, obj unstructured.Unstructured, dryRun []string) (any, error) {
+		patch := map[string]any{"spec": map[string]any{"suspend": suspend}}
+		return patchResource(ctx, client, obj.GetName(), types.MergePatchType, patch, dryRun)
+	})
+}
+
+// bindMutation looks up the resource by UID and applies the mutation, honoring the dryRun query param
+func bindMutation(resource *resources.ResourceList, dynamicClient dynamic.Interface, mapper meta.RESTMapper, mutate mutation) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		obj, found := resource.GetResource(chi.URLParam(r, "uid"))
+		if !found || !cluster.NamespaceAllowed(r.Context(), obj.GetNamespace()) {
+			http.Error(w, "Resource not found", http.StatusNotFound)
+			return
+		}
+
+		var dryRun []string
+		isDryRun := r.URL.Query().Get("dryRun") == "true"
+		if isDryRun {
+			dryRun = []string{metaV1.DryRunAll}
+		}
+
+		gvr, err := resolveGVR(resource, mapper)
+		if err != nil {
+			writeMutationResponse(w, MutationResponse{DryRun: isDryRun}, err)
+			return
+		}
+
+		var client dynamic.ResourceInterface = dynamicClient.Resource(gvr)
+		if namespace := obj.GetNamespace(); namespace != "" {
+			client = dynamicClient.Resource(gvr).Namespace(namespace)
+		}
+
+		result, err := mutate(r.Context(), client, obj, dryRun)
+		writeMutationResponse(w, MutationResponse{DryRun: isDryRun, Result: result}, err)
+	}
+}
+
+// resolveGVR returns the GVR of the resources in the list, using the REST mapper for lists backed by typed informers
+func resolveGVR(resource *resources.ResourceList, mapper meta.RESTMapper) (schema.GroupVersionResource, error) {
+	if !resource.GVR.Empty() {
+		return resource.GVR, nil
+	}
+
+	gvk := resource.GVK()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("unable to resolve resource for %s: %w", gvk.String(), err)
+	}
+
+	return mapping.Resource, nil
+}
+
+// patchResource marshals the patch and applies it to the named resource
+func patchResource(ctx context.Context, client dynamic.ResourceInterface, name string, patchType types.PatchType, patch any, dryRun []string) (any, error) {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Patch(ctx, name, patchType, data, metaV1.PatchOptions{DryRun: dryRun})
+}
+
+// writeMutationResponse writes the response as JSON, mapping API server errors to their status code
+func writeMutationResponse(w http.ResponseWriter, response MutationResponse, err error) {
+	code := http.StatusOK
+	if err != nil {
+		response.Result = nil
+		if apiStatus, ok := err.(apiErrors.APIStatus); ok {
+			status := apiStatus.Status()
+			response.Error = &status
+			code = int(status.Code)
+		} else {
+			response.Error = &metaV1.Status{
+				Status:  metaV1.StatusFailure,
+				Message: err.Error(),
+				Reason:  metaV1.StatusReasonInternalError,
+				Code:    http.StatusInternalServerError,
+			}
+			code = http.StatusInternalServerError
+		}
+		if code == 0 {
+			code = http.StatusInternalServerError
+		}
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, "Failed to marshal data", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	//nolint:errcheck
+	w.Write(data)
+}
@@ -0,0 +1,274 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	deploymentGVK = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	cronJobGVR    = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}
+)
+
+func newMockObject(apiVersion, kind, name, uid string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("default")
+	obj.SetUID(types.UID(uid))
+	return obj
+}
+
+func setupMutation(t *testing.T) (*dynamicFake.FakeDynamicClient, *resources.ResourceList, *resources.ResourceList, meta.RESTMapper) {
+	deployment := newMockObject("apps/v1", "Deployment", "podinfo", "deploy-1")
+	require.NoError(t, unstructured.SetNestedField(deployment.Object, int64(1), "spec", "replicas"))
+	cronJob := newMockObject("batch/v1", "CronJob", "backup", "cron-1")
+
+	dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		deploymentGVR: "DeploymentList",
+		cronJobGVR:    "CronJobList",
+	}, deployment, cronJob)
+
+	// Deployments are backed by a typed informer and resolved through the REST mapper
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{})
+	deployments := resources.NewResourceList(informer, deploymentGVK)
+	deployments.Resources["deploy-1"] = deployment
+
+	// CronJobs carry their GVR like dynamic resources do
+	cronJobs := &resources.ResourceList{
+		Resources:       map[string]*unstructured.Unstructured{"cron-1": cronJob},
+		SparseResources: make(map[string]*unstructured.Unstructured),
+		GVR:             cronJobGVR,
+		CRDExists:       true,
+	}
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{deploymentGVK.GroupVersion()})
+	mapper.Add(deploymentGVK, meta.RESTScopeNamespace)
+
+	return dynamicClient, deployments, cronJobs, mapper
+}
+
+func serveMutation(handler http.HandlerFunc, method, pattern, url string) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	r.Method(method, pattern, handler)
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(method, url, nil))
+	return rr
+}
+
+func TestBindDelete(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+	}{
+		{
+			name:           "Delete resource",
+			url:            "/deployments/deploy-1",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Delete non-existent resource",
+			url:            "/deployments/missing",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dynamicClient, deployments, _, mapper := setupMutation(t)
+
+			rr := serveMutation(BindDelete(deployments, dynamicClient, mapper), http.MethodDelete, "/deployments/{uid}", tt.url)
+			require.Equal(t, tt.expectedStatus, rr.Code)
+
+			_, err := dynamicClient.Resource(deploymentGVR).Namespace("default").Get(context.Background(), "podinfo", metaV1.GetOptions{})
+			if tt.expectedStatus == http.StatusNotFound {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, apiErrors.IsNotFound(err))
+
+			var response struct {
+				DryRun bool          `json:"dryRun"`
+				Result metaV1.Status `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			require.False(t, response.DryRun)
+			require.Equal(t, metaV1.StatusSuccess, response.Result.Status)
+			require.Equal(t, "podinfo", response.Result.Details.Name)
+		})
+	}
+}
+
+func TestBindMutationDryRun(t *testing.T) {
+	for _, dryRun := range []bool{true, false} {
+		dynamicClient, deployments, _, mapper := setupMutation(t)
+
+		// The fake client drops request options, so record what the mutation receives instead
+		var received []string
+		handler := bindMutation(deployments, dynamicClient, mapper, func(_ context.Context, _ dynamic.ResourceInterface, obj unstructured.Unstructured, dryRunOptions []string) (any, error) {
+			received = dryRunOptions
+			return obj.Object, nil
+		})
+
+		url := "/deployments/deploy-1"
+		if dryRun {
+			url += "?dryRun=true"
+		}
+		rr := serveMutation(handler, http.MethodPost, "/deployments/{uid}", url)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response MutationResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, dryRun, response.DryRun)
+		if dryRun {
+			require.Equal(t, []string{metaV1.DryRunAll}, received)
+		} else {
+			require.Nil(t, received)
+		}
+	}
+}
+
+func TestBindScale(t *testing.T) {
+	tests := []struct {
+		name             string
+		url              string
+		expectedStatus   int
+		expectedReplicas int64
+	}{
+		{
+			name:             "Scale up",
+			url:              "/deployments/deploy-1/scale?replicas=3",
+			expectedStatus:   http.StatusOK,
+			expectedReplicas: 3,
+		},
+		{
+			name:             "Scale to zero",
+			url:              "/deployments/deploy-1/scale?replicas=0",
+			expectedStatus:   http.StatusOK,
+			expectedReplicas: 0,
+		},
+		{
+			name:             "Missing replicas",
+			url:              "/deployments/deploy-1/scale",
+			expectedStatus:   http.StatusBadRequest,
+			expectedReplicas: 1,
+		},
+		{
+			name:             "Negative replicas",
+			url:              "/deployments/deploy-1/scale?replicas=-1",
+			expectedStatus:   http.StatusBadRequest,
+			expectedReplicas: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dynamicClient, deployments, _, mapper := setupMutation(t)
+
+			rr := serveMutation(BindScale(deployments, dynamicClient, mapper), http.MethodPost, "/deployments/{uid}/scale", tt.url)
+			require.Equal(t, tt.expectedStatus, rr.Code)
+
+			deployment, err := dynamicClient.Resource(deploymentGVR).Namespace("default").Get(context.Background(), "podinfo", metaV1.GetOptions{})
+			require.NoError(t, err)
+			replicas, _, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+			require.Equal(t, tt.expectedReplicas, replicas)
+		})
+	}
+}
+
+func TestBindRestart(t *testing.T) {
+	dynamicClient, deployments, _, mapper := setupMutation(t)
+
+	// Record the patch sent to the API server
+	var patch k8stesting.PatchActionImpl
+	dynamicClient.PrependReactor("patch", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch = action.(k8stesting.PatchActionImpl)
+		return true, newMockObject("apps/v1", "Deployment", "podinfo", "deploy-1"), nil
+	})
+
+	rr := serveMutation(BindRestart(deployments, dynamicClient, mapper), http.MethodPost, "/deployments/{uid}/restart", "/deployments/deploy-1/restart")
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "podinfo", patch.GetName())
+	require.Equal(t, types.StrategicMergePatchType, patch.GetPatchType())
+	require.Contains(t, string(patch.GetPatch()), RestartedAtAnnotation)
+
+	var response MutationResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	require.NotNil(t, response.Result)
+}
+
+func TestBindSuspend(t *testing.T) {
+	for _, suspend := range []bool{true, false} {
+		dynamicClient, _, cronJobs, mapper := setupMutation(t)
+
+		rr := serveMutation(BindSuspend(cronJobs, dynamicClient, mapper, suspend), http.MethodPost, "/cronjobs/{uid}/suspend", "/cronjobs/cron-1/suspend")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		cronJob, err := dynamicClient.Resource(cronJobGVR).Namespace("default").Get(context.Background(), "backup", metaV1.GetOptions{})
+		require.NoError(t, err)
+		suspended, _, _ := unstructured.NestedBool(cronJob.Object, "spec", "suspend")
+		require.Equal(t, suspend, suspended)
+	}
+}
+
+func TestBindMutationError(t *testing.T) {
+	dynamicClient, deployments, _, mapper := setupMutation(t)
+
+	// The API server rejects the change
+	dynamicClient.PrependReactor("patch", "deployments", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apiErrors.NewForbidden(deploymentGVR.GroupResource(), "podinfo", nil)
+	})
+
+	rr := serveMutation(BindScale(deployments, dynamicClient, mapper), http.MethodPost, "/deployments/{uid}/scale", "/deployments/deploy-1/scale?replicas=2")
+	require.Equal(t, http.StatusForbidden, rr.Code)
+
+	var response MutationResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	require.Nil(t, response.Result)
+	require.NotNil(t, response.Error)
+	require.Equal(t, metaV1.StatusReasonForbidden, response.Error.Reason)
+}
+
+func TestResolveGVR(t *testing.T) {
+	_, deployments, cronJobs, mapper := setupMutation(t)
+
+	gvr, err := resolveGVR(deployments, mapper)
+	require.NoError(t, err)
+	require.Equal(t, deploymentGVR, gvr)
+
+	gvr, err = resolveGVR(cronJobs, mapper)
+	require.NoError(t, err)
+	require.Equal(t, cronJobGVR, gvr)
+
+	// Kinds unknown to the mapper cannot be resolved
+	unknown := resources.NewResourceList(
+		cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{}),
+		schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"},
+	)
+	_, err = resolveGVR(unknown, mapper)
+	require.Error(t, err)
+}
@@ -0,0 +1,278 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats of once and single resource responses
+const (
+	outputJSON   = "json"
+	outputYAML   = "yaml"
+	outputCSV    = "csv"
+	outputNDJSON = "ndjson"
+)
+
+// outputMediaTypes maps the media types accepted in the Accept header to their output format
+var outputMediaTypes = map[string]string{
+	"application/json":     outputJSON,
+	"application/yaml":     outputYAML,
+	"application/x-yaml":   outputYAML,
+	"text/yaml":            outputYAML,
+	"text/csv":             outputCSV,
+	"application/x-ndjson": outputNDJSON,
+	"application/ndjson":   outputNDJSON,
+}
+
+// defaultCSVFields are the CSV columns when no fields are requested
+var defaultCSVFields = []string{".metadata.namespace", ".metadata.name", ".metadata.creationTimestamp"}
+
+// serverMetadata are the metadata fields set by the API server, they are stripped from YAML output
+var serverMetadata = []string{
+	"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp",
+	"deletionGracePeriodSeconds", "selfLink", "managedFields",
+}
+
+// parseOutput returns the output format from the output query param or, if it is not set, the Accept header
+// JSON is the default when neither names a supported format
+func parseOutput(r *http.Request) (string, error) {
+	if output := r.URL.Query().Get("output"); output != "" {
+		switch output {
+		case outputJSON, outputYAML, outputCSV, outputNDJSON:
+			return output, nil
+		}
+		return "", fmt.Errorf("invalid output %q, must be json, yaml, csv or ndjson", output)
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		if output, found := outputMediaTypes[mediaType]; found {
+			return output, nil
+		}
+	}
+	return outputJSON, nil
+}
+
+// writeOutput writes a single resource, list or page of resources in the output format
+// Metadata of pages that the format cannot hold, the total and continue token, is sent in the X-Total-Count and X-Continue headers
+func writeOutput(w http.ResponseWriter, output string, payload any, fieldsList []string, crdExists bool) {
+	if output == outputJSON || !crdExists {
+		writeData(w, payload, fieldsList, crdExists)
+		return
+	}
+
+	items, metadata, single := outputItems(payload)
+
+	var data []byte
+	var contentType string
+	var err error
+	switch output {
+	case outputYAML:
+		data, err = marshalYAML(items, metadata, single)
+		contentType = "application/yaml; charset=utf-8"
+	case outputCSV:
+		data, err = marshalCSV(items, fieldsList)
+		contentType = "text/csv; charset=utf-8"
+	default:
+		data, err = marshalNDJSON(payload, items, fieldsList)
+		contentType = "application/x-ndjson; charset=utf-8"
+	}
+	if err != nil {
+		http.Error(w, "Failed to marshal data", http.StatusInternalServerError)
+		return
+	}
+
+	// Set the headers
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	if metadata != nil && output != outputYAML {
+		w.Header().Set("X-Total-Count", strconv.Itoa(metadata.Total))
+		if metadata.Continue != "" {
+			w.Header().Set("X-Continue", metadata.Continue)
+		}
+	}
+
+	// Write the data to the response
+	if _, err := w.Write(data); err != nil {
+		http.Error(w, "Failed to write data", http.StatusInternalServerError)
+		return
+	}
+}
+
+// outputItems returns the resources of the payload, the page metadata if it is a page and whether it is a single resource
+func outputItems(payload any) ([]unstructured.Unstructured, *ListMetadata, bool) {
+	if cached, ok := payload.(cachedPayload); ok {
+		payload = cached.payload
+	}
+
+	switch payload := payload.(type) {
+	case unstructured.Unstructured:
+		return []unstructured.Unstructured{payload}, nil, true
+	case ListPage:
+		return payload.Items, &payload.Metadata, false
+	case []unstructured.Unstructured:
+		return payload, nil, false
+	default:
+		return nil, nil, false
+	}
+}
+
+// marshalYAML encodes the resources as kubectl apply ready YAML, a list is encoded as a v1 List
+func marshalYAML(items []unstructured.Unstructured, metadata *ListMetadata, single bool) ([]byte, error) {
+	if single && len(items) == 1 {
+		return yaml.Marshal(applyReady(items[0]))
+	}
+
+	list := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      []interface{}{},
+	}
+	for _, item := range items {
+		list["items"] = append(list["items"].([]interface{}), applyReady(item))
+	}
+	if metadata != nil && metadata.Continue != "" {
+		list["metadata"] = map[string]interface{}{"continue": metadata.Continue}
+	}
+	return yaml.Marshal(list)
+}
+
+// applyReady returns a copy of the resource without its status and the metadata populated by the API server
+func applyReady(item unstructured.Unstructured) map[string]interface{} {
+	object := deepCopy(item.Object).(map[string]interface{})
+	delete(object, "status")
+
+	if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+		for _, field := range serverMetadata {
+			delete(metadata, field)
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	return object
+}
+
+// marshalCSV encodes the resources as CSV with a column per field and a header row
+// Columns are named after their alias or field, fields selecting several values join them with commas
+func marshalCSV(items []unstructured.Unstructured, fieldsList []string) ([]byte, error) {
+	if len(fieldsList) == 0 {
+		fieldsList = defaultCSVFields
+	}
+
+	header := make([]string, len(fieldsList))
+	expressions := make([]fieldExpression, len(fieldsList))
+	for i, field := range fieldsList {
+		expression, err := parseFieldExpression(field)
+		if err != nil {
+			return nil, err
+		}
+		expressions[i] = expression
+		header[i] = expression.alias
+		if header[i] == "" {
+			header[i] = strings.TrimSpace(field)
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		record := make([]string, len(expressions))
+		for i, expression := range expressions {
+			values := extract(item.Object, expression.segments)
+			if !expression.multiple && len(values) > 1 {
+				values = values[:1]
+			}
+			cells := make([]string, len(values))
+			for j, value := range values {
+				cells[j] = csvCell(value)
+			}
+			record[i] = strings.Join(cells, ",")
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
+
+// csvCell formats a value for a CSV cell, objects and arrays are encoded as JSON
+// Strings that spreadsheets would evaluate as formulas are prefixed with a quote
+func csvCell(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+			return "'" + value
+		}
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(value)
+		return string(data)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// marshalNDJSON encodes each resource as JSON on its own line, filtering the fields if specified
+// Without fields, the encodings cached by the list are reused
+func marshalNDJSON(payload any, items []unstructured.Unstructured, fieldsList []string) ([]byte, error) {
+	var encodings [][]byte
+	switch {
+	case len(fieldsList) > 0:
+		for _, item := range filterItemsByFields(items, fieldsList) {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal data: %w", err)
+			}
+			encodings = append(encodings, data)
+		}
+	default:
+		if cached, ok := payload.(cachedPayload); ok {
+			encodings = cached.cachedJSON(items)
+		}
+	}
+
+	var buf bytes.Buffer
+	for i := range items {
+		var encoded []byte
+		if i < len(encodings) {
+			encoded = encodings[i]
+		}
+		if encoded == nil {
+			var err error
+			if encoded, err = json.Marshal(&items[i]); err != nil {
+				return nil, fmt.Errorf("failed to marshal data: %w", err)
+			}
+		}
+		buf.Write(encoded)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
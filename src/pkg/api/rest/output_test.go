@@ -0,0 +1,254 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+func outputTestPod(name, uid string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         "default",
+			"uid":               uid,
+			"resourceVersion":   "42",
+			"creationTimestamp": "2024-10-01T12:00:00Z",
+			"labels":            map[string]interface{}{"app": name},
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx"},
+				map[string]interface{}{"name": "sidecar", "image": "envoy"},
+			},
+		},
+		"status": map[string]interface{}{"phase": "Running"},
+	}}
+}
+
+func TestParseOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		accept  string
+		want    string
+		wantErr bool
+	}{
+		{name: "Default", want: outputJSON},
+		{name: "Query", query: "output=yaml", want: outputYAML},
+		{name: "Query overrides Accept", query: "output=csv", accept: "application/yaml", want: outputCSV},
+		{name: "Invalid query", query: "output=xml", wantErr: true},
+		{name: "Accept YAML", accept: "application/yaml", want: outputYAML},
+		{name: "Accept first supported type", accept: "text/html, text/csv;q=0.9, application/json", want: outputCSV},
+		{name: "Accept NDJSON", accept: "application/x-ndjson", want: outputNDJSON},
+		{name: "Accept unsupported types", accept: "text/event-stream", want: outputJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			output, err := parseOutput(req)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	pod := *outputTestPod("web", "1")
+
+	data, err := marshalYAML([]unstructured.Unstructured{pod}, nil, true)
+	require.NoError(t, err)
+
+	var object map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(data, &object))
+	require.Equal(t, map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "web",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "web"},
+		},
+		"spec": pod.Object["spec"],
+	}, object)
+
+	// The cached resource is not modified
+	require.Equal(t, *outputTestPod("web", "1"), pod)
+
+	data, err = marshalYAML([]unstructured.Unstructured{pod, *outputTestPod("db", "2")}, &ListMetadata{Total: 3, Continue: "next"}, false)
+	require.NoError(t, err)
+
+	var list map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(data, &list))
+	require.Equal(t, "List", list["kind"])
+	require.Len(t, list["items"], 2)
+	require.Equal(t, map[string]interface{}{"continue": "next"}, list["metadata"])
+}
+
+func TestMarshalCSV(t *testing.T) {
+	pods := []unstructured.Unstructured{*outputTestPod("web", "1"), *outputTestPod("=cmd", "2")}
+
+	tests := []struct {
+		name   string
+		fields []string
+		want   [][]string
+	}{
+		{
+			name: "Default columns",
+			want: [][]string{
+				{".metadata.namespace", ".metadata.name", ".metadata.creationTimestamp"},
+				{"default", "web", "2024-10-01T12:00:00Z"},
+				{"default", "'=cmd", "2024-10-01T12:00:00Z"},
+			},
+		},
+		{
+			name:   "Fields and aliases",
+			fields: []string{".metadata.name", "images:.spec.containers[*].image", "first:.spec.containers[0].name", ".metadata.labels", ".spec.nodeName"},
+			want: [][]string{
+				{".metadata.name", "images", "first", ".metadata.labels", ".spec.nodeName"},
+				{"web", "nginx,envoy", "app", `{"app":"web"}`, ""},
+				{"'=cmd", "nginx,envoy", "app", `{"app":"=cmd"}`, ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := marshalCSV(pods, tt.fields)
+			require.NoError(t, err)
+
+			records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, records)
+		})
+	}
+}
+
+func TestMarshalNDJSON(t *testing.T) {
+	pods := []unstructured.Unstructured{*outputTestPod("web", "1"), *outputTestPod("db", "2")}
+
+	data, err := marshalNDJSON(pods, pods, []string{".metadata.name"})
+	require.NoError(t, err)
+	require.Equal(t, "{\"metadata\":{\"name\":\"web\"}}\n{\"metadata\":{\"name\":\"db\"}}\n", string(data))
+
+	// Cached encodings are used when no fields are requested
+	cached := cachedPayload{payload: pods, cachedJSON: func(items []unstructured.Unstructured) [][]byte {
+		return [][]byte{[]byte(`{"cached":1}`), nil}
+	}}
+	data, err = marshalNDJSON(cached, pods, nil)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, `{"cached":1}`, lines[0])
+	require.Contains(t, lines[1], `"name":"db"`)
+}
+
+func TestBindOutput(t *testing.T) {
+	informer := &fakeInformer{}
+	resourceList := resources.NewResourceList(informer, schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	informer.handler.OnAdd(outputTestPod("web", "1"), true)
+	informer.handler.OnAdd(outputTestPod("db", "2"), true)
+
+	r := chi.NewRouter()
+	r.Get("/pods", Bind(resourceList))
+	r.Get("/pods/{uid}", Bind(resourceList))
+
+	tests := []struct {
+		name        string
+		url         string
+		accept      string
+		status      int
+		contentType string
+		contains    []string
+		headers     map[string]string
+	}{
+		{
+			name:        "JSON",
+			url:         "/pods?once=true",
+			status:      http.StatusOK,
+			contentType: "application/json; charset=utf-8",
+		},
+		{
+			name:        "YAML list",
+			url:         "/pods?once=true&output=yaml&sort=.metadata.name",
+			status:      http.StatusOK,
+			contentType: "application/yaml; charset=utf-8",
+			contains:    []string{"kind: List", "name: db", "name: web", "image: nginx"},
+		},
+		{
+			name:        "YAML single resource from Accept",
+			url:         "/pods/1",
+			accept:      "application/yaml",
+			status:      http.StatusOK,
+			contentType: "application/yaml; charset=utf-8",
+			contains:    []string{"kind: Pod", "name: web"},
+		},
+		{
+			name:        "CSV page",
+			url:         "/pods?once=true&output=csv&fields=.metadata.name,phase:.status.phase&sort=.metadata.name&limit=1",
+			status:      http.StatusOK,
+			contentType: "text/csv; charset=utf-8",
+			contains:    []string{".metadata.name,phase\ndb,Running\n"},
+			headers:     map[string]string{"X-Total-Count": "2"},
+		},
+		{
+			name:        "NDJSON",
+			url:         "/pods?once=true&output=ndjson&fields=.metadata.name&sort=.metadata.name",
+			status:      http.StatusOK,
+			contentType: "application/x-ndjson; charset=utf-8",
+			contains:    []string{"{\"metadata\":{\"name\":\"db\"}}\n{\"metadata\":{\"name\":\"web\"}}\n"},
+		},
+		{name: "Invalid output", url: "/pods?once=true&output=xml", status: http.StatusBadRequest},
+		{name: "Stream", url: "/pods?output=csv", status: http.StatusBadRequest},
+		{name: "Table", url: "/pods?once=true&output=csv&format=table", status: http.StatusBadRequest},
+		{name: "YAML with fields", url: "/pods?once=true&output=yaml&fields=.metadata.name", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.status, rr.Code, rr.Body.String())
+			if tt.contentType != "" {
+				require.Equal(t, tt.contentType, rr.Header().Get("Content-Type"))
+			}
+			for _, expected := range tt.contains {
+				require.Contains(t, rr.Body.String(), expected)
+			}
+			for header, value := range tt.headers {
+				require.Equal(t, value, rr.Header().Get(header))
+			}
+			require.NotContains(t, rr.Body.String(), "resourceVersion: \"42\"")
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// ListPage is a page of a sorted resource list
+type ListPage struct {
+	Items    []unstructured.Unstructured `json:"items"`
+	Metadata ListMetadata                `json:"metadata"`
+}
+
+// ListMetadata describes where a page is in the full list
+type ListMetadata struct {
+	// Total is the number of resources in the full list
+	Total int `json:"total"`
+	// Continue is the token to request the next page with, empty on the last page
+	Continue string `json:"continue,omitempty"`
+}
+
+// continueToken is the decoded form of the opaque continue query param
+// It holds the position of the last resource on the previous page, so resources added or removed between
+// requests do not shift the next page
+type continueToken struct {
+	Key       interface{} `json:"key,omitempty"`
+	Namespace string      `json:"namespace,omitempty"`
+	Name      string      `json:"name"`
+	UID       string      `json:"uid"`
+}
+
+// sortPosition is the position of a resource in the sorted list
+type sortPosition struct {
+	key       interface{}
+	namespace string
+	name      string
+	uid       string
+}
+
+// listOptions are the sort and pagination options of a list request
+type listOptions struct {
+	sortBy     *jsonpath.JSONPath
+	descending bool
+	limit      int
+	next       *continueToken
+}
+
+// parseListOptions parses the sort, order, limit and continue query params
+func parseListOptions(r *http.Request) (listOptions, error) {
+	query := r.URL.Query()
+	var opts listOptions
+
+	if sort := query.Get("sort"); sort != "" {
+		sortBy := jsonpath.New("sort").AllowMissingKeys(true)
+		if err := sortBy.Parse(relaxedJSONPath(sort)); err != nil {
+			return opts, fmt.Errorf("invalid sort: %w", err)
+		}
+		opts.sortBy = sortBy
+	}
+
+	switch order := query.Get("order"); order {
+	case "", "asc":
+	case "desc":
+		opts.descending = true
+	default:
+		return opts, fmt.Errorf("invalid order %q, must be asc or desc", order)
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value < 1 {
+			return opts, fmt.Errorf("invalid limit %q, must be a positive integer", limit)
+		}
+		opts.limit = value
+	}
+
+	if token := query.Get("continue"); token != "" {
+		if opts.limit == 0 {
+			return opts, fmt.Errorf("continue requires limit")
+		}
+		next, err := decodeContinueToken(token)
+		if err != nil {
+			return opts, err
+		}
+		opts.next = next
+	}
+
+	return opts, nil
+}
+
+// relaxedJSONPath wraps a bare path such as .metadata.name or metadata.name in braces, like kubectl --sort-by
+func relaxedJSONPath(path string) string {
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+	return "{." + strings.TrimPrefix(path, ".") + "}"
+}
+
+// apply sorts the items and, if a limit is set, returns the requested page
+// lookup returns the object the sort path is evaluated on, so sparse items can be sorted by fields they do not include
+func (o listOptions) apply(items []unstructured.Unstructured, lookup func(unstructured.Unstructured) map[string]interface{}) any {
+	positions := o.sort(items, lookup)
+
+	if o.limit == 0 {
+		return items
+	}
+
+	// Start after the last resource of the previous page
+	start := 0
+	if o.next != nil {
+		last := sortPosition{key: o.next.Key, namespace: o.next.Namespace, name: o.next.Name, uid: o.next.UID}
+		start, _ = slices.BinarySearchFunc(positions, last, func(position, last sortPosition) int {
+			if o.compare(position, last) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+	end := min(start+o.limit, len(items))
+
+	page := ListPage{
+		Items:    items[start:end],
+		Metadata: ListMetadata{Total: len(items)},
+	}
+	if end < len(items) {
+		last := positions[end-1]
+		page.Metadata.Continue = encodeContinueToken(continueToken{
+			Key:       last.key,
+			Namespace: last.namespace,
+			Name:      last.name,
+			UID:       last.uid,
+		})
+	}
+
+	return page
+}
+
+// sort orders the items by the sort path, then by namespace, name and UID so the order is stable
+// It returns the position of each item in the sorted list
+func (o listOptions) sort(items []unstructured.Unstructured, lookup func(unstructured.Unstructured) map[string]interface{}) []sortPosition {
+	type positionedItem struct {
+		item     unstructured.Unstructured
+		position sortPosition
+	}
+
+	// Evaluate the sort path once per item rather than once per comparison
+	positioned := make([]positionedItem, len(items))
+	for i, item := range items {
+		positioned[i] = positionedItem{
+			item: item,
+			position: sortPosition{
+				namespace: item.GetNamespace(),
+				name:      item.GetName(),
+				uid:       string(item.GetUID()),
+			},
+		}
+		if o.sortBy != nil {
+			positioned[i].position.key = o.sortKey(lookup(item))
+		}
+	}
+
+	slices.SortFunc(positioned, func(a, b positionedItem) int {
+		return o.compare(a.position, b.position)
+	})
+
+	positions := make([]sortPosition, len(items))
+	for i := range positioned {
+		items[i] = positioned[i].item
+		positions[i] = positioned[i].position
+	}
+	return positions
+}
+
+// compare compares two positions in the requested order
+func (o listOptions) compare(a, b sortPosition) int {
+	result := cmp.Or(
+		compareValues(a.key, b.key),
+		strings.Compare(a.namespace, b.namespace),
+		strings.Compare(a.name, b.name),
+		strings.Compare(a.uid, b.uid),
+	)
+	if o.descending {
+		return -result
+	}
+	return result
+}
+
+// sortKey returns the first value at the sort path, or nil if the path does not exist
+func (o listOptions) sortKey(object map[string]interface{}) interface{} {
+	results, err := o.sortBy.FindResults(object)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return nil
+	}
+	value := results[0][0]
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	return value.Interface()
+}
+
+// compareValues compares two sort keys, missing values sort first
+// Numbers compare numerically, booleans false before true, anything else by its string form
+// RFC 3339 timestamps such as metadata.creationTimestamp compare correctly as strings
+func compareValues(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	aNumber, aIsNumber := toFloat(a)
+	bNumber, bIsNumber := toFloat(b)
+	if aIsNumber && bIsNumber {
+		return cmp.Compare(aNumber, bNumber)
+	}
+
+	aBool, aIsBool := a.(bool)
+	bBool, bIsBool := b.(bool)
+	if aIsBool && bIsBool {
+		switch {
+		case aBool == bBool:
+			return 0
+		case bBool:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// toFloat converts the numeric types found in unstructured objects to a float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// encodeContinueToken encodes the token as opaque URL safe base64 JSON
+func encodeContinueToken(token continueToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeContinueToken decodes a token created by encodeContinueToken
+func decodeContinueToken(token string) (*continueToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid continue token")
+	}
+	var next continueToken
+	if err := json.Unmarshal(data, &next); err != nil {
+		return nil, fmt.Errorf("invalid continue token")
+	}
+	return &next, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func pageTestItems() []unstructured.Unstructured {
+	items := []unstructured.Unstructured{}
+	for i, spec := range []struct {
+		namespace, name, created string
+		restarts                 interface{}
+	}{
+		{"b", "web", "2024-01-03T00:00:00Z", int64(10)},
+		{"a", "web", "2024-01-01T00:00:00Z", int64(2)},
+		{"a", "db", "2024-01-02T00:00:00Z", nil},
+		{"c", "cache", "2024-01-01T00:00:00Z", 2.5},
+	} {
+		item := unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"namespace":         spec.namespace,
+				"name":              spec.name,
+				"uid":               fmt.Sprint(i),
+				"creationTimestamp": spec.created,
+			},
+		}}
+		if spec.restarts != nil {
+			item.Object["status"] = map[string]interface{}{"restarts": spec.restarts}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func itemNames(items []unstructured.Unstructured) []string {
+	names := []string{}
+	for _, item := range items {
+		names = append(names, item.GetNamespace()+"/"+item.GetName())
+	}
+	return names
+}
+
+func TestListOptionsSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "Default order", query: "", expected: []string{"a/db", "a/web", "b/web", "c/cache"}},
+		{name: "Default order descending", query: "order=desc", expected: []string{"c/cache", "b/web", "a/web", "a/db"}},
+		{name: "Timestamp", query: "sort=.metadata.creationTimestamp", expected: []string{"a/web", "c/cache", "a/db", "b/web"}},
+		{name: "Relaxed path", query: "sort=metadata.name", expected: []string{"c/cache", "a/db", "a/web", "b/web"}},
+		{name: "Braced path", query: "sort={.metadata.name}&order=desc", expected: []string{"b/web", "a/web", "a/db", "c/cache"}},
+		{name: "Numbers with missing values", query: "sort=.status.restarts", expected: []string{"a/db", "a/web", "c/cache", "b/web"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := parseListOptions(httptest.NewRequest("GET", "/?"+tt.query, nil))
+			require.NoError(t, err)
+
+			items := pageTestItems()
+			result := opts.apply(items, func(item unstructured.Unstructured) map[string]interface{} { return item.Object })
+			require.Equal(t, tt.expected, itemNames(result.([]unstructured.Unstructured)))
+		})
+	}
+}
+
+func TestListOptionsPaginate(t *testing.T) {
+	lookup := func(item unstructured.Unstructured) map[string]interface{} { return item.Object }
+	query := "/?sort=.metadata.creationTimestamp&limit=3"
+
+	opts, err := parseListOptions(httptest.NewRequest("GET", query, nil))
+	require.NoError(t, err)
+	first := opts.apply(pageTestItems(), lookup).(ListPage)
+	require.Equal(t, []string{"a/web", "c/cache", "a/db"}, itemNames(first.Items))
+	require.Equal(t, 4, first.Metadata.Total)
+	require.NotEmpty(t, first.Metadata.Continue)
+
+	opts, err = parseListOptions(httptest.NewRequest("GET", query+"&continue="+first.Metadata.Continue, nil))
+	require.NoError(t, err)
+	second := opts.apply(pageTestItems(), lookup).(ListPage)
+	require.Equal(t, []string{"b/web"}, itemNames(second.Items))
+	require.Equal(t, 4, second.Metadata.Total)
+	require.Empty(t, second.Metadata.Continue)
+
+	// Removing the last item of the previous page does not skip the next item
+	items := append(pageTestItems()[:2], pageTestItems()[3])
+	second = opts.apply(items, lookup).(ListPage)
+	require.Equal(t, []string{"b/web"}, itemNames(second.Items))
+	require.Equal(t, 3, second.Metadata.Total)
+
+	// Adding an item before the page boundary does not repeat items
+	items = append(pageTestItems(), unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "a", "name": "new", "uid": "4", "creationTimestamp": "2023-12-31T00:00:00Z"},
+	}})
+	second = opts.apply(items, lookup).(ListPage)
+	require.Equal(t, []string{"b/web"}, itemNames(second.Items))
+
+	// Numeric sort keys survive the round trip through the token
+	opts, err = parseListOptions(httptest.NewRequest("GET", "/?sort=.status.restarts&order=desc&limit=2", nil))
+	require.NoError(t, err)
+	first = opts.apply(pageTestItems(), lookup).(ListPage)
+	require.Equal(t, []string{"b/web", "c/cache"}, itemNames(first.Items))
+
+	opts, err = parseListOptions(httptest.NewRequest("GET", "/?sort=.status.restarts&order=desc&limit=2&continue="+first.Metadata.Continue, nil))
+	require.NoError(t, err)
+	second = opts.apply(pageTestItems(), lookup).(ListPage)
+	require.Equal(t, []string{"a/web", "a/db"}, itemNames(second.Items))
+	require.Empty(t, second.Metadata.Continue)
+}
+
+func TestParseListOptionsInvalid(t *testing.T) {
+	for _, query := range []string{
+		"sort={.metadata.name",
+		"order=up",
+		"limit=0",
+		"limit=ten",
+		"continue=abc",
+		"limit=10&continue=not-base64!",
+	} {
+		_, err := parseListOptions(httptest.NewRequest("GET", "/?"+query, nil))
+		require.Error(t, err, query)
+	}
+}
+
+func TestJSONMarshalPageFields(t *testing.T) {
+	page := ListPage{Items: pageTestItems()[:1], Metadata: ListMetadata{Total: 4, Continue: "token"}}
+
+	data, err := jsonMarshal(page, []string{".metadata.name"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"items":[{"metadata":{"name":"web"}}],"metadata":{"total":4,"continue":"token"}}`, string(data))
+}
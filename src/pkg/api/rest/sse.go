@@ -5,13 +5,20 @@ package rest
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/telemetry"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+var (
+	sseConnections = telemetry.NewGauge("uds_runtime_sse_connections", "Number of connected SSE clients")
+	sseEvents      = telemetry.NewCounter("uds_runtime_sse_events_total", "Number of SSE events sent to clients")
+)
+
 // WriteHeaders sets the headers for an SSE connection
 func WriteHeaders(w http.ResponseWriter) {
 	w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
@@ -21,6 +28,14 @@ func WriteHeaders(w http.ResponseWriter) {
 
 // Handler is a generic SSE handler that sends data to the client
 func Handler(w http.ResponseWriter, r *http.Request, getData func(string, string) []unstructured.Unstructured, changes <-chan struct{}, fieldsList []string, crdExists func() bool) {
+	namespace := r.URL.Query().Get("namespace")
+	namePartial := r.URL.Query().Get("name")
+
+	stream(w, r, func() any { return getData(namespace, namePartial) }, changes, fieldsList, crdExists)
+}
+
+// stream sends the payload to the client as SSE, initially and whenever there are changes
+func stream(w http.ResponseWriter, r *http.Request, getPayload func() any, changes <-chan struct{}, fieldsList []string, crdExists func() bool) {
 	WriteHeaders(w)
 
 	// Ensure the ResponseWriter supports flushing
@@ -30,8 +45,8 @@ func Handler(w http.ResponseWriter, r *http.Request, getData func(string, string
 		return
 	}
 
-	namespace := r.URL.Query().Get("namespace")
-	namePartial := r.URL.Query().Get("name")
+	sseConnections.Inc()
+	defer sseConnections.Dec()
 
 	// Track the last sent time
 	var lastSent time.Time
@@ -65,7 +80,7 @@ func Handler(w http.ResponseWriter, r *http.Request, getData func(string, string
 		}
 
 		// Convert the data to JSON
-		data, err := jsonMarshal(getData(namespace, namePartial), fieldsList)
+		data, err := jsonMarshal(getPayload(), fieldsList)
 		if err != nil {
 			fmt.Fprintf(w, "data: Error: %v\n\n", err)
 			return
@@ -73,6 +88,7 @@ func Handler(w http.ResponseWriter, r *http.Request, getData func(string, string
 
 		// Write the data to the response
 		fmt.Fprintf(w, "data: %s\n\n", data)
+		sseEvents.Inc()
 
 		// Update the last sent time and reset the pending flag
 		lastSent = now
@@ -108,3 +124,75 @@ func Handler(w http.ResponseWriter, r *http.Request, getData func(string, string
 		}
 	}
 }
+
+// streamResource sends a single resource as SSE, initially and whenever its resource version changes
+// When the resource is no longer found, its last version is sent as a deleted event, the stream then ends if terminal
+// is set or otherwise continues, sending the resource again if it is recreated
+func streamResource(w http.ResponseWriter, r *http.Request, getResource func() (unstructured.Unstructured, bool), getPayload func(unstructured.Unstructured) any, changes <-chan struct{}, fieldsList []string, terminal bool) {
+	WriteHeaders(w)
+
+	// Ensure the ResponseWriter supports flushing
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported!", http.StatusInternalServerError)
+		return
+	}
+
+	sseConnections.Inc()
+	defer sseConnections.Dec()
+
+	// Track the last version sent, it is nil once the resource is deleted
+	var last *unstructured.Unstructured
+
+	// sendData sends the resource if it was modified or deleted and returns whether it was deleted
+	sendData := func() bool {
+		data, found := getResource()
+		switch {
+		case found && last != nil && last.GetUID() == data.GetUID() && last.GetResourceVersion() == data.GetResourceVersion():
+			// The change was to another resource or a resync
+			return false
+		case found:
+			writeResourceEvent(w, "", getPayload(data), fieldsList)
+			last = &data
+		case last != nil:
+			writeResourceEvent(w, "deleted", getPayload(*last), fieldsList)
+			last = nil
+		default:
+			return false
+		}
+		flusher.Flush()
+		return last == nil
+	}
+
+	// Send the initial data
+	sendData()
+
+	for {
+		select {
+		// If the context is done, return
+		case <-r.Context().Done():
+			return
+
+		// If there is a change, send the resource if it was modified or deleted
+		case <-changes:
+			if sendData() && terminal {
+				return
+			}
+		}
+	}
+}
+
+// writeResourceEvent writes the payload as an SSE event, the event type is omitted if empty
+func writeResourceEvent(w io.Writer, event string, payload any, fieldsList []string) {
+	data, err := jsonMarshal(payload, fieldsList)
+	if err != nil {
+		fmt.Fprintf(w, "data: Error: %v\n\n", err)
+		return
+	}
+
+	if event != "" {
+		fmt.Fprintf(w, "event: %s\n", event)
+	}
+	fmt.Fprintf(w, "data: %s\n\n", data)
+	sseEvents.Inc()
+}
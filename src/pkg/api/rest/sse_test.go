@@ -4,6 +4,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http/httptest"
 	"testing"
@@ -63,3 +64,29 @@ func TestHandler(t *testing.T) {
 	expectedBody := "data: " + string(expectedJSON) + "\n\n"
 	require.Contains(t, rr.Body.String(), expectedBody)
 }
+
+func TestHandlerTelemetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest("GET", "/", nil).WithContext(ctx)
+	getData := func(string, string) []unstructured.Unstructured {
+		return []unstructured.Unstructured{}
+	}
+
+	connections := sseConnections.Get()
+	events := sseEvents.Get()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Handler(httptest.NewRecorder(), req, getData, make(chan struct{}), nil, nil)
+	}()
+
+	// The client is counted while connected
+	require.Eventually(t, func() bool {
+		return sseConnections.Get() == connections+1 && sseEvents.Get() == events+1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+	require.Equal(t, connections, sseConnections.Get())
+}
@@ -0,0 +1,24 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// toTable converts a list or page of resources to a table with the printer columns of the resource's kind
+// The continue token of a page is kept in the table metadata
+func toTable(resource *resources.ResourceList, payload any) any {
+	switch payload := payload.(type) {
+	case []unstructured.Unstructured:
+		return resource.Table(payload)
+	case ListPage:
+		table := resource.Table(payload.Items)
+		table.Continue = payload.Metadata.Continue
+		return table
+	default:
+		return payload
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/go-chi/chi/v5"
+)
+
+// BindUsage binds the usage history of the resource identified by the uid URL param, e.g. for a sparkline
+// The history is streamed and sent again whenever metrics are collected, unless once is true
+func BindUsage(resource *resources.ResourceList, getUsage func(uid string) []resources.Usage, changes *resources.Broadcaster) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		uid := chi.URLParam(r, "uid")
+
+		if resource.Forbidden {
+			http.Error(w, fmt.Sprintf("Not allowed to list %s resources", resource.GVK().Kind), http.StatusForbidden)
+			return
+		}
+
+		// If the resource is not found or not accessible to the user, return a 404
+		data, found := resource.GetResource(uid)
+		if !found || !cluster.NamespaceAllowed(r.Context(), data.GetNamespace()) ||
+			!cluster.ResourceAllowed(r.Context(), "get", resource.GVR, data.GetNamespace(), data.GetName()) {
+			http.Error(w, "Resource not found", http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("once") == "true" {
+			writeData(w, getUsage(uid), nil, true)
+			return
+		}
+
+		WriteHeaders(w)
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming unsupported!", http.StatusInternalServerError)
+			return
+		}
+
+		sseConnections.Inc()
+		defer sseConnections.Dec()
+
+		updates, unsubscribe := changes.Subscribe()
+		defer unsubscribe()
+
+		sendData := func() {
+			defer flusher.Flush()
+			data, err := json.Marshal(getUsage(uid))
+			if err != nil {
+				fmt.Fprintf(w, "data: Error: %v\n\n", err)
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			sseEvents.Inc()
+		}
+
+		// Send the initial data
+		sendData()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-updates:
+				sendData()
+			}
+		}
+	}
+}
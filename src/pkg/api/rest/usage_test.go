@@ -0,0 +1,88 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/defenseunicorns/uds-runtime/src/test"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBindUsage(t *testing.T) {
+	resourceList := &resources.ResourceList{
+		Resources: map[string]*unstructured.Unstructured{
+			"1": test.CreateMockPod("mock-pod-1", "uds-dev-stack", "1"),
+		},
+		CRDExists: true,
+	}
+	usage := resources.NewResourceHistory(10)
+	timestamp := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	usage.Add("1", resources.Usage{Timestamp: timestamp, CPU: 100, Memory: 1024})
+	var changes resources.Broadcaster
+
+	r := chi.NewRouter()
+	r.Get("/resources/workloads/pods/{uid}/metrics", BindUsage(resourceList, usage.Get, &changes))
+
+	tests := []struct {
+		name             string
+		url              string
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:             "Usage history once",
+			url:              "/resources/workloads/pods/1/metrics?once=true",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `[{"Timestamp":"2024-10-01T00:00:00Z","CPU":100,"Memory":1024}]`,
+		},
+		{
+			name:             "Usage history of a non-existent resource",
+			url:              "/resources/workloads/pods/2/metrics?once=true",
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: "Resource not found\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			require.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+
+	t.Run("Usage history stream", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		req := httptest.NewRequest(http.MethodGet, "/resources/workloads/pods/1/metrics", nil).WithContext(ctx)
+		rr := httptest.NewRecorder()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			r.ServeHTTP(rr, req)
+		}()
+
+		// A collection sends the history again
+		require.Eventually(t, func() bool { return changes.Subscribers() == 1 }, time.Second, 10*time.Millisecond)
+		changes.Notify()
+		<-done
+		require.Zero(t, changes.Subscribers())
+
+		event := `data: [{"Timestamp":"2024-10-01T00:00:00Z","CPU":100,"Memory":1024}]` + "\n\n"
+		require.Equal(t, "text/event-stream; charset=utf-8", rr.Header().Get("Content-Type"))
+		require.Equal(t, event+event, rr.Body.String())
+	})
+}
@@ -4,23 +4,30 @@
 package api
 
 import (
+	"context"
 	"crypto/tls"
 	"embed"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/fs"
 	"log"
 	"log/slog"
 	"net/http"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/defenseunicorns/pkg/exec"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
 	_ "github.com/defenseunicorns/uds-runtime/src/pkg/api/docs" //nolint:staticcheck
 	udsMiddleware "github.com/defenseunicorns/uds-runtime/src/pkg/api/middleware"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/monitor"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/telemetry"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/config"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/k8s/client"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/k8s/session"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -36,6 +43,14 @@ func Setup(assets *embed.FS) (*chi.Mux, bool, error) {
 	// configure config vars for local or in-cluster auth
 	auth.Configure()
 
+	// configure the namespaces the cache is limited to, if any
+	configureWatchNamespaces()
+
+	// configure the metrics collection interval and the retention and persistence of metrics history
+	if err := configureMetrics(); err != nil {
+		return nil, false, fmt.Errorf("failed to configure metrics: %w", err)
+	}
+
 	// Create a k8s session
 	k8sSession, err := session.CreateK8sSession()
 	if err != nil {
@@ -44,6 +59,11 @@ func Setup(assets *embed.FS) (*chi.Mux, bool, error) {
 
 	inCluster := k8sSession.InCluster
 
+	// Filter cached resources by the impersonated user's RBAC
+	if config.ImpersonationEnabled {
+		cluster.SetAccessReviewer(cluster.NewAccessReviewer(k8sSession.GetClients().Clientset, cluster.AccessReviewTTL))
+	}
+
 	if !inCluster {
 		// Start the cluster monitoring goroutine
 		go k8sSession.StartClusterMonitoring()
@@ -58,6 +78,9 @@ func Setup(assets *embed.FS) (*chi.Mux, bool, error) {
 	r.Use(udsMiddleware.ConditionalCompress)
 
 	r.Get("/healthz", healthz)
+	// Runtime metrics are exempt from auth, set METRICS_TOKEN to require it as a bearer token instead
+	resources.RegisterCacheMetrics(func() *resources.Cache { return k8sSession.GetCache() })
+	r.Get("/metrics", telemetry.Handler(os.Getenv("METRICS_TOKEN")))
 	r.Get("/swagger", func(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, "/swagger/index.html", http.StatusMovedPermanently)
 	})
@@ -68,46 +91,89 @@ func Setup(assets *embed.FS) (*chi.Mux, bool, error) {
 		r.Route("/monitor", func(r chi.Router) {
 			r.Get("/pepr/", monitor.Pepr)
 			r.Get("/pepr/{stream}", monitor.Pepr)
-			r.Get("/cluster-overview", monitor.BindClusterOverviewHandler(k8sSession.Cache))
+			r.Get("/cluster-overview", withLatestCache(k8sSession, monitor.BindClusterOverviewHandler))
 		})
 
+		// Kubeconfig contexts, switching rebuilds the clients and cache for the chosen context
+		r.Get("/contexts", getContexts(k8sSession))
+		r.Post("/contexts/switch", switchContext(k8sSession))
+
+		// Single resources are looked up by UID or, for links from alerts, logs and events, by namespace and name
 		r.Route("/resources", func(r chi.Router) {
 			r.Get("/nodes", withLatestCache(k8sSession, getNodes))
 			r.Get("/nodes/{uid}", withLatestCache(k8sSession, getNode))
+			r.Get("/nodes/name/{name}", withLatestCache(k8sSession, getNodeByName))
+			// Node metrics are collected with pod metrics on each metrics interval
+			r.Get("/nodes/metrics", withLatestCache(k8sSession, getNodeMetrics))
+			r.Get("/nodes/{uid}/metrics", withLatestCache(k8sSession, getNodeUsage))
 
 			r.Get("/events", withLatestCache(k8sSession, getEvents))
 			r.Get("/events/{uid}", withLatestCache(k8sSession, getEvent))
+			r.Get("/events/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getEventByName))
 
 			r.Get("/namespaces", withLatestCache(k8sSession, getNamespaces))
 			r.Get("/namespaces/{uid}", withLatestCache(k8sSession, getNamespace))
+			r.Get("/namespaces/name/{name}", withLatestCache(k8sSession, getNamespaceByName))
 
 			r.Get("/custom-resource-definitions", withLatestCache(k8sSession, getCRDs))
 			r.Get("/custom-resource-definitions/{uid}", withLatestCache(k8sSession, getCRD))
+			r.Get("/custom-resource-definitions/name/{name}", withLatestCache(k8sSession, getCRDByName))
+
+			// Arbitrary custom resources have their informers started on demand and stopped when idle
+			r.Get("/custom/{group}/{version}/{resource}", withLatestCache(k8sSession, getCustomResources))
+			r.Get("/custom/{group}/{version}/{resource}/{uid}", withLatestCache(k8sSession, getCustomResource))
+			r.Get("/custom/{group}/{version}/{resource}/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getCustomResourceByName))
+			r.Get("/custom/{group}/{version}/{resource}/name/{name}", withLatestCache(k8sSession, getCustomResourceByName))
 
 			// Workload resources
 			r.Route("/workloads", func(r chi.Router) {
 				r.Get("/pods", withLatestCache(k8sSession, getPods))
 				r.Get("/pods/{uid}", withLatestCache(k8sSession, getPod))
+				r.Get("/pods/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getPodByName))
+				r.Get("/pods/{uid}/metrics", withLatestCache(k8sSession, getPodUsage))
+				r.Get("/pods/{uid}/logs", withLatestSession(k8sSession, getPodLogs))
+				r.Get("/pods/{uid}/exec", withLatestSession(k8sSession, execPod))
+				r.Delete("/pods/{uid}", withLatestSession(k8sSession, deletePod))
 
 				r.Get("/deployments", withLatestCache(k8sSession, getDeployments))
 				r.Get("/deployments/{uid}", withLatestCache(k8sSession, getDeployment))
+				r.Get("/deployments/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getDeploymentByName))
+				r.Get("/deployments/{uid}/logs", withLatestSession(k8sSession, getDeploymentLogs))
+				r.Delete("/deployments/{uid}", withLatestSession(k8sSession, deleteDeployment))
+				r.Post("/deployments/{uid}/restart", withLatestSession(k8sSession, restartDeployment))
+				r.Post("/deployments/{uid}/scale", withLatestSession(k8sSession, scaleDeployment))
 
 				r.Get("/daemonsets", withLatestCache(k8sSession, getDaemonsets))
 				r.Get("/daemonsets/{uid}", withLatestCache(k8sSession, getDaemonset))
+				r.Get("/daemonsets/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getDaemonsetByName))
+				r.Get("/daemonsets/{uid}/logs", withLatestSession(k8sSession, getDaemonsetLogs))
+				r.Delete("/daemonsets/{uid}", withLatestSession(k8sSession, deleteDaemonset))
+				r.Post("/daemonsets/{uid}/restart", withLatestSession(k8sSession, restartDaemonset))
 
 				r.Get("/statefulsets", withLatestCache(k8sSession, getStatefulsets))
 				r.Get("/statefulsets/{uid}", withLatestCache(k8sSession, getStatefulset))
+				r.Get("/statefulsets/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getStatefulsetByName))
+				r.Get("/statefulsets/{uid}/logs", withLatestSession(k8sSession, getStatefulsetLogs))
+				r.Delete("/statefulsets/{uid}", withLatestSession(k8sSession, deleteStatefulset))
+				r.Post("/statefulsets/{uid}/restart", withLatestSession(k8sSession, restartStatefulset))
+				r.Post("/statefulsets/{uid}/scale", withLatestSession(k8sSession, scaleStatefulset))
 
 				r.Get("/jobs", withLatestCache(k8sSession, getJobs))
 				r.Get("/jobs/{uid}", withLatestCache(k8sSession, getJob))
+				r.Get("/jobs/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getJobByName))
+				r.Delete("/jobs/{uid}", withLatestSession(k8sSession, deleteJob))
 
 				r.Get("/cronjobs", withLatestCache(k8sSession, getCronJobs))
 				r.Get("/cronjobs/{uid}", withLatestCache(k8sSession, getCronJob))
+				r.Get("/cronjobs/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getCronJobByName))
+				r.Delete("/cronjobs/{uid}", withLatestSession(k8sSession, deleteCronJob))
+				r.Post("/cronjobs/{uid}/suspend", withLatestSession(k8sSession, suspendCronJob))
+				r.Post("/cronjobs/{uid}/resume", withLatestSession(k8sSession, resumeCronJob))
 
-				// Metrics have their own cache and change channel that updates every 30 seconds
+				// Metrics have their own cache and change channel that updates on each metrics interval
 				// They do not support informers directly, so we need to poll the API
 				r.Get("/podmetrics", func(w http.ResponseWriter, r *http.Request) {
-					getPodMetrics(w, r, k8sSession.Cache)
+					getPodMetrics(w, r, k8sSession.GetCache())
 				})
 			})
 
@@ -115,69 +181,107 @@ func Setup(assets *embed.FS) (*chi.Mux, bool, error) {
 			r.Route("/configs", func(r chi.Router) {
 				r.Get("/uds-packages", withLatestCache(k8sSession, getUDSPackages))
 				r.Get("/uds-packages/{uid}", withLatestCache(k8sSession, getUDSPackage))
+				r.Get("/uds-packages/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getUDSPackageByName))
+				r.Delete("/uds-packages/{uid}", withLatestSession(k8sSession, deleteUDSPackage))
 
 				r.Get("/uds-exemptions", withLatestCache(k8sSession, getUDSExemptions))
 				r.Get("/uds-exemptions/{uid}", withLatestCache(k8sSession, getUDSExemption))
+				r.Get("/uds-exemptions/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getUDSExemptionByName))
+				r.Delete("/uds-exemptions/{uid}", withLatestSession(k8sSession, deleteUDSExemption))
 
 				r.Get("/configmaps", withLatestCache(k8sSession, getConfigMaps))
 				r.Get("/configmaps/{uid}", withLatestCache(k8sSession, getConfigMap))
+				r.Get("/configmaps/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getConfigMapByName))
+				r.Delete("/configmaps/{uid}", withLatestSession(k8sSession, deleteConfigMap))
 
 				r.Get("/secrets", withLatestCache(k8sSession, getSecrets))
 				r.Get("/secrets/{uid}", withLatestCache(k8sSession, getSecret))
+				r.Get("/secrets/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getSecretByName))
+				r.Delete("/secrets/{uid}", withLatestSession(k8sSession, deleteSecret))
 			})
 
 			// Cluster ops resources
 			r.Route("/cluster-ops", func(r chi.Router) {
 				r.Get("/mutatingwebhooks", withLatestCache(k8sSession, getMutatingWebhooks))
 				r.Get("/mutatingwebhooks/{uid}", withLatestCache(k8sSession, getMutatingWebhook))
+				r.Get("/mutatingwebhooks/name/{name}", withLatestCache(k8sSession, getMutatingWebhookByName))
+				r.Delete("/mutatingwebhooks/{uid}", withLatestSession(k8sSession, deleteMutatingWebhook))
 
 				r.Get("/validatingwebhooks", withLatestCache(k8sSession, getValidatingWebhooks))
 				r.Get("/validatingwebhooks/{uid}", withLatestCache(k8sSession, getValidatingWebhook))
+				r.Get("/validatingwebhooks/name/{name}", withLatestCache(k8sSession, getValidatingWebhookByName))
+				r.Delete("/validatingwebhooks/{uid}", withLatestSession(k8sSession, deleteValidatingWebhook))
 
 				r.Get("/hpas", withLatestCache(k8sSession, getHPAs))
 				r.Get("/hpas/{uid}", withLatestCache(k8sSession, getHPA))
+				r.Get("/hpas/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getHPAByName))
+				r.Delete("/hpas/{uid}", withLatestSession(k8sSession, deleteHPA))
 
 				r.Get("/priority-classes", withLatestCache(k8sSession, getPriorityClasses))
 				r.Get("/priority-classes/{uid}", withLatestCache(k8sSession, getPriorityClass))
+				r.Get("/priority-classes/name/{name}", withLatestCache(k8sSession, getPriorityClassByName))
+				r.Delete("/priority-classes/{uid}", withLatestSession(k8sSession, deletePriorityClass))
 
 				r.Get("/runtime-classes", withLatestCache(k8sSession, getRuntimeClasses))
 				r.Get("/runtime-classes/{uid}", withLatestCache(k8sSession, getRuntimeClass))
+				r.Get("/runtime-classes/name/{name}", withLatestCache(k8sSession, getRuntimeClassByName))
+				r.Delete("/runtime-classes/{uid}", withLatestSession(k8sSession, deleteRuntimeClass))
 
 				r.Get("/poddisruptionbudgets", withLatestCache(k8sSession, getPodDisruptionBudgets))
 				r.Get("/poddisruptionbudgets/{uid}", withLatestCache(k8sSession, getPodDisruptionBudget))
+				r.Get("/poddisruptionbudgets/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getPodDisruptionBudgetByName))
+				r.Delete("/poddisruptionbudgets/{uid}", withLatestSession(k8sSession, deletePodDisruptionBudget))
 
 				r.Get("/limit-ranges", withLatestCache(k8sSession, getLimitRanges))
 				r.Get("/limit-ranges/{uid}", withLatestCache(k8sSession, getLimitRange))
+				r.Get("/limit-ranges/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getLimitRangeByName))
+				r.Delete("/limit-ranges/{uid}", withLatestSession(k8sSession, deleteLimitRange))
 
 				r.Get("/resource-quotas", withLatestCache(k8sSession, getResourceQuotas))
 				r.Get("/resource-quotas/{uid}", withLatestCache(k8sSession, getResourceQuota))
+				r.Get("/resource-quotas/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getResourceQuotaByName))
+				r.Delete("/resource-quotas/{uid}", withLatestSession(k8sSession, deleteResourceQuota))
 			})
 
 			// Network resources
 			r.Route("/networks", func(r chi.Router) {
 				r.Get("/services", withLatestCache(k8sSession, getServices))
 				r.Get("/services/{uid}", withLatestCache(k8sSession, getService))
+				r.Get("/services/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getServiceByName))
+				r.Delete("/services/{uid}", withLatestSession(k8sSession, deleteService))
 
 				r.Get("/networkpolicies", withLatestCache(k8sSession, getNetworkPolicies))
 				r.Get("/networkpolicies/{uid}", withLatestCache(k8sSession, getNetworkPolicy))
+				r.Get("/networkpolicies/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getNetworkPolicyByName))
+				r.Delete("/networkpolicies/{uid}", withLatestSession(k8sSession, deleteNetworkPolicy))
 
 				r.Get("/endpoints", withLatestCache(k8sSession, getEndpoints))
 				r.Get("/endpoints/{uid}", withLatestCache(k8sSession, getEndpoint))
+				r.Get("/endpoints/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getEndpointByName))
+				r.Delete("/endpoints/{uid}", withLatestSession(k8sSession, deleteEndpoint))
 
 				r.Get("/virtualservices", withLatestCache(k8sSession, getVirtualServices))
 				r.Get("/virtualservices/{uid}", withLatestCache(k8sSession, getVirtualService))
+				r.Get("/virtualservices/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getVirtualServiceByName))
+				r.Delete("/virtualservices/{uid}", withLatestSession(k8sSession, deleteVirtualService))
 			})
 
 			// Storage resources
 			r.Route("/storage", func(r chi.Router) {
 				r.Get("/persistentvolumes", withLatestCache(k8sSession, getPersistentVolumes))
 				r.Get("/persistentvolumes/{uid}", withLatestCache(k8sSession, getPersistentVolume))
+				r.Get("/persistentvolumes/name/{name}", withLatestCache(k8sSession, getPersistentVolumeByName))
+				r.Delete("/persistentvolumes/{uid}", withLatestSession(k8sSession, deletePersistentVolume))
 
 				r.Get("/persistentvolumeclaims", withLatestCache(k8sSession, getPersistentVolumeClaims))
 				r.Get("/persistentvolumeclaims/{uid}", withLatestCache(k8sSession, getPersistentVolumeClaim))
+				r.Get("/persistentvolumeclaims/ns/{namespace}/name/{name}", withLatestCache(k8sSession, getPersistentVolumeClaimByName))
+				r.Delete("/persistentvolumeclaims/{uid}", withLatestSession(k8sSession, deletePersistentVolumeClaim))
 
 				r.Get("/storageclasses", withLatestCache(k8sSession, getStorageClasses))
 				r.Get("/storageclasses/{uid}", withLatestCache(k8sSession, getStorageClass))
+				r.Get("/storageclasses/name/{name}", withLatestCache(k8sSession, getStorageClassByName))
+				r.Delete("/storageclasses/{uid}", withLatestSession(k8sSession, deleteStorageClass))
 			})
 		})
 	})
@@ -288,9 +392,126 @@ func Serve(r *chi.Mux, localCert []byte, localKey []byte, inCluster bool) error
 	return nil
 }
 
+// configureWatchNamespaces reads the comma separated WATCH_NAMESPACES env var into the config
+func configureWatchNamespaces() {
+	for _, namespace := range strings.Split(os.Getenv("WATCH_NAMESPACES"), ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			config.WatchNamespaces = append(config.WatchNamespaces, namespace)
+		}
+	}
+
+	if len(config.WatchNamespaces) > 0 {
+		slog.Info("Cache limited to namespaces", "namespaces", config.WatchNamespaces)
+	}
+}
+
+// configureMetrics reads the METRICS_INTERVAL, METRICS_SOURCE and PROMETHEUS_URL env vars into the config
+// and the METRICS_HISTORY_* env vars into the metrics history options
+func configureMetrics() error {
+	if value := os.Getenv("METRICS_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return fmt.Errorf("invalid METRICS_INTERVAL %q, must be a positive duration", value)
+		}
+		config.MetricsInterval = interval
+	}
+
+	if source := os.Getenv("METRICS_SOURCE"); source != "" {
+		if source != resources.MetricsSourceMetricsServer && source != resources.MetricsSourcePrometheus {
+			return fmt.Errorf("invalid METRICS_SOURCE %q, must be %s or %s", source, resources.MetricsSourceMetricsServer, resources.MetricsSourcePrometheus)
+		}
+		config.MetricsSource = source
+	}
+	if url := os.Getenv("PROMETHEUS_URL"); url != "" {
+		config.PrometheusURL = url
+	}
+	if config.MetricsSource == resources.MetricsSourcePrometheus {
+		slog.Info("Collecting metrics from Prometheus", "url", config.PrometheusURL)
+	}
+
+	options := resources.DefaultHistoryOptions()
+	options.Dir = os.Getenv("METRICS_HISTORY_DIR")
+
+	for name, duration := range map[string]*time.Duration{
+		"METRICS_HISTORY_RETENTION":     &options.Retention,
+		"METRICS_HISTORY_RAW_RETENTION": &options.RawRetention,
+		"METRICS_HISTORY_RESOLUTION":    &options.Resolution,
+	} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+		*duration = parsed
+	}
+
+	if err := resources.ConfigureHistory(options); err != nil {
+		return err
+	}
+
+	if options.Dir != "" {
+		slog.Info("Persisting metrics history", "dir", options.Dir, "retention", options.Retention)
+	}
+	return nil
+}
+
 // withLatestCache returns a wrapper lambda function, creating a closure that can dynamically access the latest cache
 func withLatestCache(k8sSession *session.K8sSession, handler func(cache *resources.Cache) func(w http.ResponseWriter, r *http.Request)) http.HandlerFunc {
+	return endOnSwitch(k8sSession, func(w http.ResponseWriter, r *http.Request) {
+		handler(k8sSession.GetCache())(w, r)
+	})
+}
+
+// withLatestSession returns a wrapper lambda function, creating a closure that can dynamically access the latest cache and clients
+func withLatestSession(k8sSession *session.K8sSession, handler func(cache *resources.Cache, clients *client.Clients) func(w http.ResponseWriter, r *http.Request)) http.HandlerFunc {
+	return endOnSwitch(k8sSession, func(w http.ResponseWriter, r *http.Request) {
+		clients := k8sSession.GetClients()
+
+		// Act as the request's user so Kubernetes RBAC applies to mutations, logs and exec
+		if user, ok := cluster.UserFromContext(r.Context()); ok {
+			impersonated, err := clients.Impersonate(user.Name, user.Groups)
+			if err != nil {
+				slog.Error("Failed to create impersonated clients", "error", err)
+				http.Error(w, "Failed to create impersonated clients", http.StatusInternalServerError)
+				return
+			}
+			clients = impersonated
+		}
+
+		handler(k8sSession.GetCache(), clients)(w, r)
+	})
+}
+
+// endOnSwitch ends the request when the session switches to another context, so streams don't keep serving the previous cluster
+// Event streams get a final cluster-switched event telling the client to reopen them
+func endOnSwitch(k8sSession *session.K8sSession, next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		handler(k8sSession.Cache)(w, r)
+		switched := k8sSession.Switched()
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-switched:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		next(w, r.WithContext(ctx))
+
+		select {
+		case <-switched:
+			if strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream") {
+				data, _ := json.Marshal(map[string]string{"context": k8sSession.GetCurrentCtx()})
+				fmt.Fprintf(w, "event: cluster-switched\ndata: %s\n\n", data)
+				if flusher, ok := w.(http.Flusher); ok {
+					flusher.Flush()
+				}
+			}
+		default:
+		}
 	}
 }
@@ -0,0 +1,315 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+// Package telemetry contains the runtime's own metrics and serves them in the Prometheus text format.
+package telemetry
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// metric is a metric family that can write itself in the Prometheus text format
+type metric interface {
+	write(w io.Writer)
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = make(map[string]metric)
+)
+
+// register adds the metric to the registry, names must be unique
+func register(name string, m metric) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, found := registry[name]; found {
+		panic(fmt.Sprintf("telemetry: metric %s is already registered", name))
+	}
+	registry[name] = m
+}
+
+// Handler serves the registered metrics in the Prometheus text format
+// When token is not empty, requests must send it as a bearer token
+func Handler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if token != "" {
+			bearer := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		Write(w)
+	}
+}
+
+// Write writes the registered metrics sorted by name
+func Write(w io.Writer) {
+	registryMutex.RLock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	metrics := make([]metric, len(names))
+	sort.Strings(names)
+	for i, name := range names {
+		metrics[i] = registry[name]
+	}
+	registryMutex.RUnlock()
+
+	for _, m := range metrics {
+		m.write(w)
+	}
+}
+
+// Sample is a value with the values of its metric's labels
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// vector holds the values of a metric per combination of label values
+type vector struct {
+	mutex  sync.Mutex
+	name   string
+	help   string
+	kind   string
+	labels []string
+	values map[string]*Sample
+}
+
+func newVector(name, help, kind string, labels []string) *vector {
+	v := &vector{name: name, help: help, kind: kind, labels: labels, values: make(map[string]*Sample)}
+	register(name, v)
+	return v
+}
+
+// add adds delta to the value of the label values
+func (v *vector) add(delta float64, labelValues []string) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.sample(labelValues).Value += delta
+}
+
+// set sets the value of the label values
+func (v *vector) set(value float64, labelValues []string) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.sample(labelValues).Value = value
+}
+
+// get returns the value of the label values
+func (v *vector) get(labelValues []string) float64 {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	return v.sample(labelValues).Value
+}
+
+// sample returns the sample of the label values, creating it if needed, the caller must hold the mutex
+func (v *vector) sample(labelValues []string) *Sample {
+	if len(labelValues) != len(v.labels) {
+		panic(fmt.Sprintf("telemetry: %s has %d labels, got %d values", v.name, len(v.labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	s, found := v.values[key]
+	if !found {
+		s = &Sample{LabelValues: append([]string(nil), labelValues...)}
+		v.values[key] = s
+	}
+	return s
+}
+
+func (v *vector) write(w io.Writer) {
+	v.mutex.Lock()
+	samples := make([]Sample, 0, len(v.values))
+	for _, s := range v.values {
+		samples = append(samples, *s)
+	}
+	v.mutex.Unlock()
+
+	writeFamily(w, v.name, v.help, v.kind, v.labels, samples)
+}
+
+// Counter is a value that only goes up, e.g. the number of handled events
+type Counter struct {
+	*vector
+}
+
+// NewCounter registers a counter with the given label names
+func NewCounter(name, help string, labels ...string) *Counter {
+	return &Counter{newVector(name, help, "counter", labels)}
+}
+
+// Inc increments the counter of the label values
+func (c *Counter) Inc(labelValues ...string) {
+	c.add(1, labelValues)
+}
+
+// Get returns the counter of the label values
+func (c *Counter) Get(labelValues ...string) float64 {
+	return c.get(labelValues)
+}
+
+// Gauge is a value that can go up and down, e.g. the number of connected clients
+type Gauge struct {
+	*vector
+}
+
+// NewGauge registers a gauge with the given label names
+func NewGauge(name, help string, labels ...string) *Gauge {
+	return &Gauge{newVector(name, help, "gauge", labels)}
+}
+
+// Set sets the gauge of the label values
+func (g *Gauge) Set(value float64, labelValues ...string) {
+	g.set(value, labelValues)
+}
+
+// Inc increments the gauge of the label values
+func (g *Gauge) Inc(labelValues ...string) {
+	g.add(1, labelValues)
+}
+
+// Dec decrements the gauge of the label values
+func (g *Gauge) Dec(labelValues ...string) {
+	g.add(-1, labelValues)
+}
+
+// Get returns the gauge of the label values
+func (g *Gauge) Get(labelValues ...string) float64 {
+	return g.get(labelValues)
+}
+
+// gaugeFunc is a gauge whose samples are collected when the metrics are written
+type gaugeFunc struct {
+	name    string
+	help    string
+	labels  []string
+	collect func() []Sample
+}
+
+// NewGaugeFunc registers a gauge whose samples are collected by calling collect on each scrape
+func NewGaugeFunc(name, help string, labels []string, collect func() []Sample) {
+	register(name, &gaugeFunc{name: name, help: help, labels: labels, collect: collect})
+}
+
+func (g *gaugeFunc) write(w io.Writer) {
+	writeFamily(w, g.name, g.help, "gauge", g.labels, g.collect())
+}
+
+// Histogram counts observations in buckets, e.g. durations
+type Histogram struct {
+	mutex   sync.Mutex
+	name    string
+	help    string
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+// NewHistogram registers a histogram with the given bucket upper bounds in increasing order
+func NewHistogram(name, help string, buckets []float64) *Histogram {
+	h := &Histogram{name: name, help: help, buckets: buckets, counts: make([]uint64, len(buckets))}
+	register(name, h)
+	return h
+}
+
+// Observe adds an observation to the histogram
+func (h *Histogram) Observe(value float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for i, bound := range h.buckets {
+		if value <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// Count returns the number of observations
+func (h *Histogram) Count() uint64 {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.count
+}
+
+func (h *Histogram) write(w io.Writer) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", h.name, escapeHelp(h.help), h.name)
+	for i, bound := range h.buckets {
+		fmt.Fprintf(w, "%s_bucket{le=\"%s\"} %d\n", h.name, formatValue(bound), h.counts[i])
+	}
+	fmt.Fprintf(w, "%s_bucket{le=\"+Inf\"} %d\n", h.name, h.count)
+	fmt.Fprintf(w, "%s_sum %s\n", h.name, formatValue(h.sum))
+	fmt.Fprintf(w, "%s_count %d\n", h.name, h.count)
+}
+
+// writeFamily writes a counter or gauge family, samples are sorted by their label values
+func writeFamily(w io.Writer, name, help, kind string, labels []string, samples []Sample) {
+	sort.Slice(samples, func(i, j int) bool {
+		return strings.Join(samples[i].LabelValues, "\xff") < strings.Join(samples[j].LabelValues, "\xff")
+	})
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, escapeHelp(help), name, kind)
+	for _, s := range samples {
+		fmt.Fprintf(w, "%s%s %s\n", name, formatLabels(labels, s.LabelValues), formatValue(s.Value))
+	}
+}
+
+// formatLabels formats the label pairs, e.g. {kind="Pod"}
+func formatLabels(labels, values []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, len(labels))
+	for i, label := range labels {
+		value := ""
+		if i < len(values) {
+			value = values[i]
+		}
+		pairs[i] = label + `="` + escapeLabelValue(value) + `"`
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+// formatValue formats a sample value, including the special values of the text format
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package telemetry
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	counter := NewCounter("test_events_total", "Number of events", "kind", "type")
+	counter.Inc("Pod", "ADDED")
+	counter.Inc("Pod", "ADDED")
+	counter.Inc("Node", `with "quotes"`)
+	require.Equal(t, float64(2), counter.Get("Pod", "ADDED"))
+
+	gauge := NewGauge("test_connections", "Number of\nconnections")
+	gauge.Inc()
+	gauge.Inc()
+	gauge.Dec()
+
+	histogram := NewHistogram("test_duration_seconds", "Duration", []float64{0.5, 1})
+	histogram.Observe(0.25)
+	histogram.Observe(0.75)
+	histogram.Observe(5)
+
+	NewGaugeFunc("test_synced", "Whether synced", []string{"kind"}, func() []Sample {
+		return []Sample{{LabelValues: []string{"Pod"}, Value: 1}, {LabelValues: []string{"Node"}, Value: math.NaN()}}
+	})
+
+	var out strings.Builder
+	Write(&out)
+
+	expected := []string{
+		"# HELP test_connections Number of\\nconnections\n# TYPE test_connections gauge\ntest_connections 1\n",
+		"# HELP test_duration_seconds Duration\n# TYPE test_duration_seconds histogram\n" +
+			"test_duration_seconds_bucket{le=\"0.5\"} 1\n" +
+			"test_duration_seconds_bucket{le=\"1\"} 2\n" +
+			"test_duration_seconds_bucket{le=\"+Inf\"} 3\n" +
+			"test_duration_seconds_sum 6\n" +
+			"test_duration_seconds_count 3\n",
+		"# HELP test_events_total Number of events\n# TYPE test_events_total counter\n" +
+			"test_events_total{kind=\"Node\",type=\"with \\\"quotes\\\"\"} 1\n" +
+			"test_events_total{kind=\"Pod\",type=\"ADDED\"} 2\n",
+		"# HELP test_synced Whether synced\n# TYPE test_synced gauge\n" +
+			"test_synced{kind=\"Node\"} NaN\n" +
+			"test_synced{kind=\"Pod\"} 1\n",
+	}
+	for _, family := range expected {
+		require.Contains(t, out.String(), family)
+	}
+
+	// Families are sorted by name
+	require.Less(t, strings.Index(out.String(), "test_connections"), strings.Index(out.String(), "test_synced"))
+
+	// Names are unique
+	require.Panics(t, func() { NewGauge("test_connections", "Duplicate") })
+	// Label values must match the label names
+	require.Panics(t, func() { counter.Inc("Pod") })
+}
+
+func TestHandler(t *testing.T) {
+	NewCounter("test_requests_total", "Number of requests").Inc()
+
+	tests := []struct {
+		name           string
+		token          string
+		authorization  string
+		expectedStatus int
+	}{
+		{
+			name:           "No token required",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Valid token",
+			token:          "secret",
+			authorization:  "Bearer secret",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid token",
+			token:          "secret",
+			authorization:  "Bearer guess",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Missing token",
+			token:          "secret",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rr := httptest.NewRecorder()
+
+			Handler(tt.token)(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rr.Header().Get("Content-Type"))
+				require.Contains(t, rr.Body.String(), "test_requests_total 1\n")
+			}
+		})
+	}
+}
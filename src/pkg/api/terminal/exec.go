@@ -0,0 +1,261 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+// Package terminal contains the logic for interactive pod exec sessions over WebSockets
+package terminal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/auth/cluster"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/zarf-dev/zarf/src/pkg/message"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	// StdinMessage carries input from the client to the container
+	StdinMessage = "stdin"
+	// ResizeMessage carries a new terminal size from the client
+	ResizeMessage = "resize"
+	// StdoutMessage carries output from the container to the client
+	StdoutMessage = "stdout"
+	// StderrMessage carries error output from the container to the client, only used without a TTY
+	StderrMessage = "stderr"
+	// ErrorMessage is sent to the client when the exec session fails
+	ErrorMessage = "error"
+	// ExitMessage is sent to the client when the exec session ends
+	ExitMessage = "exit"
+)
+
+// defaultCommand is run when the client does not request a command
+var defaultCommand = []string{"/bin/sh"}
+
+// Message is a single message exchanged over the exec WebSocket
+// Data is base64 encoded in JSON, so output that is not valid UTF-8, e.g. binary output or a multibyte character split
+// across writes, reaches the client unchanged
+type Message struct {
+	Type string `json:"type"`
+	Data []byte `json:"data,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+}
+
+// Declare newExecutor as a variable so it can be mocked
+var newExecutor = func(config *rest.Config, method string, url *url.URL) (remotecommand.Executor, error) {
+	return remotecommand.NewSPDYExecutor(config, method, url)
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// BindExecHandler runs a command in a pod, looked up by UID, streaming stdin, stdout, stderr and resizes over a WebSocket
+func BindExecHandler(pods *resources.ResourceList, clientset kubernetes.Interface, config *rest.Config) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pod, found := pods.GetResource(chi.URLParam(r, "uid"))
+		if !found || !cluster.NamespaceAllowed(r.Context(), pod.GetNamespace()) {
+			http.Error(w, "Resource not found", http.StatusNotFound)
+			return
+		}
+
+		container := r.URL.Query().Get("container")
+		if container == "" {
+			container = defaultContainer(pod)
+		}
+
+		command := r.URL.Query()["command"]
+		if len(command) == 0 {
+			command = defaultCommand
+		}
+		tty := r.URL.Query().Get("tty") != "false"
+
+		req := clientset.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Namespace(pod.GetNamespace()).
+			Name(pod.GetName()).
+			SubResource("exec").
+			VersionedParams(&corev1.PodExecOptions{
+				Container: container,
+				Command:   command,
+				Stdin:     true,
+				Stdout:    true,
+				Stderr:    !tty,
+				TTY:       tty,
+			}, scheme.ParameterCodec)
+
+		executor, err := newExecutor(config, http.MethodPost, req.URL())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to create executor: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader has already replied to the client
+			message.WarnErr(err, "Failed to upgrade exec connection")
+			return
+		}
+		defer conn.Close()
+
+		newSession(conn).run(r.Context(), executor, tty)
+	}
+}
+
+// session multiplexes a single exec stream over a WebSocket connection
+type session struct {
+	conn       *websocket.Conn
+	writeMutex sync.Mutex
+	stdin      *io.PipeReader
+	stdinPipe  *io.PipeWriter
+	sizes      chan remotecommand.TerminalSize
+}
+
+// newSession creates a new exec session for the connection
+func newSession(conn *websocket.Conn) *session {
+	stdin, stdinPipe := io.Pipe()
+	return &session{
+		conn:      conn,
+		stdin:     stdin,
+		stdinPipe: stdinPipe,
+		sizes:     make(chan remotecommand.TerminalSize, 1),
+	}
+}
+
+// run streams the exec session until the command exits or the client disconnects
+func (s *session) run(requestCtx context.Context, executor remotecommand.Executor, tty bool) {
+	ctx, cancel := context.WithCancel(requestCtx)
+	defer cancel()
+
+	// Read client messages until the client disconnects, then tear down the stream
+	go func() {
+		defer cancel()
+		defer s.stdinPipe.Close()
+		defer close(s.sizes)
+		s.readMessages()
+	}()
+
+	options := remotecommand.StreamOptions{
+		Stdin:  s.stdin,
+		Stdout: &messageWriter{session: s, messageType: StdoutMessage},
+		Tty:    tty,
+	}
+	if tty {
+		options.TerminalSizeQueue = s
+	} else {
+		options.Stderr = &messageWriter{session: s, messageType: StderrMessage}
+	}
+
+	err := executor.StreamWithContext(ctx, options)
+
+	// Nothing left to tell the client if it has gone away
+	if requestCtx.Err() != nil {
+		return
+	}
+
+	if err != nil {
+		s.send(Message{Type: ErrorMessage, Data: []byte(err.Error())})
+	}
+	s.send(Message{Type: ExitMessage})
+
+	// Let the client know the session is over
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	//nolint:errcheck
+	s.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// readMessages reads stdin and resize messages from the client until the connection closes
+func (s *session) readMessages() {
+	for {
+		var msg Message
+		if err := s.conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				message.Debugf("Exec connection closed: %v", err)
+			}
+			return
+		}
+
+		switch msg.Type {
+		case StdinMessage:
+			if _, err := s.stdinPipe.Write(msg.Data); err != nil {
+				return
+			}
+
+		case ResizeMessage:
+			size := remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
+			// Only the latest size matters, replace any size that has not been consumed yet
+			select {
+			case <-s.sizes:
+			default:
+			}
+			s.sizes <- size
+		}
+	}
+}
+
+// Next returns the next terminal size, it implements remotecommand.TerminalSizeQueue
+func (s *session) Next() *remotecommand.TerminalSize {
+	size, ok := <-s.sizes
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+// send writes a message to the client, writes are serialized as the WebSocket supports only one concurrent writer
+func (s *session) send(msg Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// messageWriter sends everything written to it to the client as messages of the given type
+type messageWriter struct {
+	session     *session
+	messageType string
+}
+
+// Write sends the data to the client
+func (m *messageWriter) Write(p []byte) (int, error) {
+	if err := m.session.send(Message{Type: m.messageType, Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// defaultContainer returns the container kubectl would pick for the pod
+func defaultContainer(pod unstructured.Unstructured) string {
+	if name, ok := pod.GetAnnotations()["kubectl.kubernetes.io/default-container"]; ok {
+		return name
+	}
+
+	containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "containers")
+	if len(containers) > 0 {
+		if container, ok := containers[0].(map[string]interface{}); ok {
+			name, _ := container["name"].(string)
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package terminal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// fakeExecutor echoes stdin lines back to stdout and reports terminal resizes
+type fakeExecutor struct {
+	url *url.URL
+}
+
+func (f *fakeExecutor) Stream(options remotecommand.StreamOptions) error {
+	return f.StreamWithContext(context.Background(), options)
+}
+
+func (f *fakeExecutor) StreamWithContext(ctx context.Context, options remotecommand.StreamOptions) error {
+	if options.TerminalSizeQueue != nil {
+		go func() {
+			for size := options.TerminalSizeQueue.Next(); size != nil; size = options.TerminalSizeQueue.Next() {
+				fmt.Fprintf(options.Stdout, "resized %dx%d", size.Width, size.Height)
+			}
+		}()
+	}
+
+	scanner := bufio.NewScanner(options.Stdin)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch line {
+		case "exit":
+			return nil
+		case "binary":
+			// Bytes that are not valid UTF-8, followed by the first half of a two byte character
+			//nolint:errcheck
+			options.Stdout.Write([]byte{0xff, 0x00, 0xfe, 0xc3})
+			continue
+		}
+		if options.Stderr != nil {
+			fmt.Fprintf(options.Stderr, "err: %s", line)
+		}
+		fmt.Fprintf(options.Stdout, "echo: %s", line)
+	}
+
+	return ctx.Err()
+}
+
+func TestBindExecHandler(t *testing.T) {
+	var executor *fakeExecutor
+	newExecutor = func(_ *rest.Config, _ string, url *url.URL) (remotecommand.Executor, error) {
+		executor = &fakeExecutor{url: url}
+		return executor, nil
+	}
+
+	pods := &resources.ResourceList{Resources: map[string]*unstructured.Unstructured{
+		"pod-1": {Object: map[string]interface{}{
+			"kind":     "Pod",
+			"metadata": map[string]interface{}{"name": "podinfo-1", "namespace": "podinfo", "uid": "pod-1"},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "podinfo"}},
+			},
+		}},
+	}}
+
+	// The clientset is only used to build the exec URL, no requests are sent to the host
+	config := &rest.Config{Host: "https://127.0.0.1:6443"}
+	clientset, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/pods/{uid}/exec", BindExecHandler(pods, clientset, config))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	readMessage := func(t *testing.T, conn *websocket.Conn) Message {
+		var msg Message
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		require.NoError(t, conn.ReadJSON(&msg))
+		return msg
+	}
+
+	t.Run("Pod not found", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL+"/pods/missing/exec", nil)
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("TTY session", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL+"/pods/pod-1/exec", nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		// The default container and command are used
+		query := executor.url.Query()
+		require.Equal(t, "/api/v1/namespaces/podinfo/pods/podinfo-1/exec", executor.url.Path)
+		require.Equal(t, "podinfo", query.Get("container"))
+		require.Equal(t, []string{"/bin/sh"}, query["command"])
+		require.Equal(t, "true", query.Get("tty"))
+
+		require.NoError(t, conn.WriteJSON(Message{Type: ResizeMessage, Cols: 120, Rows: 40}))
+		require.Equal(t, Message{Type: StdoutMessage, Data: []byte("resized 120x40")}, readMessage(t, conn))
+
+		require.NoError(t, conn.WriteJSON(Message{Type: StdinMessage, Data: []byte("ls\n")}))
+		require.Equal(t, Message{Type: StdoutMessage, Data: []byte("echo: ls")}, readMessage(t, conn))
+
+		// Output that is not valid UTF-8 is sent unchanged, base64 encoded
+		require.NoError(t, conn.WriteJSON(Message{Type: StdinMessage, Data: []byte("binary\n")}))
+		_, raw, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.JSONEq(t, `{"type":"stdout","data":"/wD+ww=="}`, string(raw))
+
+		require.NoError(t, conn.WriteJSON(Message{Type: StdinMessage, Data: []byte("exit\n")}))
+		require.Equal(t, Message{Type: ExitMessage}, readMessage(t, conn))
+
+		// The server closes the connection once the session ends
+		_, _, err = conn.ReadMessage()
+		require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+	})
+
+	t.Run("Non-TTY session with stderr", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL+"/pods/pod-1/exec?tty=false&container=sidecar&command=cat&command=-", nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		query := executor.url.Query()
+		require.Equal(t, "sidecar", query.Get("container"))
+		require.Equal(t, []string{"cat", "-"}, query["command"])
+		require.Equal(t, "true", query.Get("stderr"))
+
+		require.NoError(t, conn.WriteJSON(Message{Type: StdinMessage, Data: []byte("hello\n")}))
+		require.Equal(t, Message{Type: StderrMessage, Data: []byte("err: hello")}, readMessage(t, conn))
+		require.Equal(t, Message{Type: StdoutMessage, Data: []byte("echo: hello")}, readMessage(t, conn))
+	})
+}
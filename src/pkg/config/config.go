@@ -4,7 +4,19 @@
 // Package config contains configuration for the application.
 package config
 
+import "time"
+
 var (
 	LocalAuthEnabled     = true
 	InClusterAuthEnabled = false
+	// ImpersonationEnabled makes in-cluster requests act as the JWT subject and groups so Kubernetes RBAC applies
+	ImpersonationEnabled = false
+	// WatchNamespaces limits the cache to these namespaces, the whole cluster is watched when empty
+	WatchNamespaces []string
+	// MetricsInterval is how often pod and node metrics are collected
+	MetricsInterval = 30 * time.Second
+	// MetricsSource is where metrics are collected from, either metrics-server or prometheus
+	MetricsSource = "metrics-server"
+	// PrometheusURL is the Prometheus HTTP API queried when MetricsSource is prometheus, defaults to the one deployed by UDS Core
+	PrometheusURL = "http://kube-prometheus-stack-prometheus.monitoring.svc.cluster.local:9090"
 )
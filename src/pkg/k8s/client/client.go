@@ -5,9 +5,17 @@ package client
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 	metricsv "k8s.io/metrics/pkg/client/clientset/versioned"
 )
@@ -16,19 +24,105 @@ import (
 type Clients struct {
 	Clientset     *kubernetes.Clientset
 	MetricsClient *metricsv.Clientset
-	Config        *rest.Config
+	DynamicClient dynamic.Interface
+	// RESTMapper maps kinds to resources, discovery is deferred until the first lookup
+	RESTMapper meta.RESTMapper
+	Config     *rest.Config
+
+	// impersonated caches the clients impersonating each user and groups
+	mutex        sync.Mutex
+	impersonated map[impersonationKey]impersonatedClients
+	// prunedAt is when expired clients were last removed, they are removed at most once per ImpersonationTTL
+	prunedAt time.Time
+}
+
+// ImpersonationTTL is how long the clients impersonating a user are reused
+const ImpersonationTTL = 10 * time.Minute
+
+// impersonationKey identifies the cached clients of a user and groups
+type impersonationKey struct {
+	user   string
+	groups string
+}
+
+// impersonatedClients are cached clients impersonating a user
+type impersonatedClients struct {
+	clients *Clients
+	expires time.Time
 }
 
-// NewClient creates new Kubernetes cluster clients
+// NewClient creates new Kubernetes cluster clients for the kubeconfig's current context
 func NewClient() (*Clients, error) {
+	return NewClientForContext("")
+}
+
+// NewClientForContext creates new Kubernetes cluster clients for the named kubeconfig context
+// The kubeconfig's current context is used if the name is empty
+func NewClientForContext(contextName string) (*Clients, error) {
 	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		clientcmd.NewDefaultClientConfigLoadingRules(),
-		&clientcmd.ConfigOverrides{}).ClientConfig()
+		&clientcmd.ConfigOverrides{CurrentContext: contextName}).ClientConfig()
+
+	if err != nil {
+		return nil, err
+	}
 
+	clients, err := newClientsForConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
+	clients.RESTMapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clients.Clientset.Discovery()))
+	return clients, nil
+}
+
+// Impersonate returns clients that act as the given user and groups, so the API server enforces their RBAC
+// The REST mapper is shared with the original clients, the clients are cached for ImpersonationTTL
+func (c *Clients) Impersonate(user string, groups []string) (*Clients, error) {
+	key := impersonationKey{user: user, groups: strings.Join(groups, "\n")}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if cached, found := c.impersonated[key]; found && time.Now().Before(cached.expires) {
+		return cached.clients, nil
+	}
+
+	config := rest.CopyConfig(c.Config)
+	config.Impersonate = rest.ImpersonationConfig{
+		UserName: user,
+		Groups:   groups,
+	}
+
+	clients, err := newClientsForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clients impersonating %s: %w", user, err)
+	}
+	clients.RESTMapper = c.RESTMapper
+
+	now := time.Now()
+	if now.Sub(c.prunedAt) >= ImpersonationTTL {
+		c.pruneImpersonated(now)
+	}
+	if c.impersonated == nil {
+		c.impersonated = make(map[impersonationKey]impersonatedClients)
+	}
+	c.impersonated[key] = impersonatedClients{clients: clients, expires: now.Add(ImpersonationTTL)}
+	return clients, nil
+}
+
+// pruneImpersonated removes expired impersonated clients, the caller must hold the mutex
+// Clients are kept for at most twice ImpersonationTTL, without scanning every cached user on each miss
+func (c *Clients) pruneImpersonated(now time.Time) {
+	c.prunedAt = now
+	for key, cached := range c.impersonated {
+		if now.After(cached.expires) {
+			delete(c.impersonated, key)
+		}
+	}
+}
+
+// newClientsForConfig creates the clientset, metrics and dynamic clients for the config
+func newClientsForConfig(config *rest.Config) (*Clients, error) {
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -39,9 +133,15 @@ func NewClient() (*Clients, error) {
 		return nil, err
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Clients{
 		Clientset:     clientset,
 		MetricsClient: metricsClient,
+		DynamicClient: dynamicClient,
 		Config:        config,
 	}, nil
 }
@@ -74,3 +174,27 @@ var GetCurrentContext = func() (string, string, error) {
 	}
 	return contextName, context.Cluster, nil
 }
+
+// KubeContext is a context from the kubeconfig
+type KubeContext struct {
+	Name    string `json:"name"`
+	Cluster string `json:"cluster"`
+}
+
+// Declare GetContexts as a variable so it can be mocked
+var GetContexts = func() ([]KubeContext, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).RawConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	contexts := make([]KubeContext, 0, len(config.Contexts))
+	for name, context := range config.Contexts {
+		contexts = append(contexts, KubeContext{Name: name, Cluster: context.Cluster})
+	}
+	slices.SortFunc(contexts, func(a, b KubeContext) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return contexts, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestImpersonate(t *testing.T) {
+	clients, err := newClientsForConfig(&rest.Config{Host: "https://localhost:6443"})
+	require.NoError(t, err)
+
+	admin, err := clients.Impersonate("admin", []string{"admins", "auditors"})
+	require.NoError(t, err)
+	require.Equal(t, "admin", admin.Config.Impersonate.UserName)
+	require.Equal(t, []string{"admins", "auditors"}, admin.Config.Impersonate.Groups)
+	require.Empty(t, clients.Config.Impersonate.UserName)
+
+	// The clients of a user and groups are reused
+	cached, err := clients.Impersonate("admin", []string{"admins", "auditors"})
+	require.NoError(t, err)
+	require.Same(t, admin, cached)
+
+	// Other groups or users get their own clients
+	other, err := clients.Impersonate("admin", []string{"admins"})
+	require.NoError(t, err)
+	require.NotSame(t, admin, other)
+	other, err = clients.Impersonate("auditor", []string{"admins", "auditors"})
+	require.NoError(t, err)
+	require.NotSame(t, admin, other)
+
+	// Expired clients are created again
+	clients.mutex.Lock()
+	for key, cached := range clients.impersonated {
+		cached.expires = time.Now().Add(-time.Second)
+		clients.impersonated[key] = cached
+	}
+	clients.mutex.Unlock()
+	renewed, err := clients.Impersonate("admin", []string{"admins", "auditors"})
+	require.NoError(t, err)
+	require.NotSame(t, admin, renewed)
+
+	// Expired clients are only pruned once ImpersonationTTL has passed since the last prune
+	require.Len(t, clients.impersonated, 3)
+	clients.mutex.Lock()
+	clients.prunedAt = time.Now().Add(-ImpersonationTTL)
+	clients.mutex.Unlock()
+	_, err = clients.Impersonate("auditor", []string{"auditors"})
+	require.NoError(t, err)
+	require.Len(t, clients.impersonated, 2)
+}
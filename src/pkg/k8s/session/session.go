@@ -5,19 +5,25 @@ package session
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/resources"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/api/rest"
+	"github.com/defenseunicorns/uds-runtime/src/pkg/api/telemetry"
 	"github.com/defenseunicorns/uds-runtime/src/pkg/k8s/client"
 )
 
 type K8sSession struct {
+	// Clients, Cache, CurrentCtx and ready are replaced by context switches and reconnections while requests are
+	// served, they are read with GetClients, GetCache, GetCurrentCtx and isReady outside of them
 	Clients        *client.Clients
 	Cache          *resources.Cache
 	Cancel         context.CancelFunc
@@ -28,13 +34,37 @@ type K8sSession struct {
 	ready          bool
 	createCache    createCache
 	createClient   createClient
+	// mutex serializes context switches and reconnections
+	mutex sync.Mutex
+	// stateMutex guards the fields replaced by context switches and reconnections, it is only held to read or
+	// replace them so requests are not blocked while a new cache syncs
+	stateMutex sync.RWMutex
+	// switched is closed when the session switches to another context
+	switched      chan struct{}
+	switchedMutex sync.Mutex
 }
 
-type createClient func() (*client.Clients, error)
+type createClient func(contextName string) (*client.Clients, error)
 type createCache func(ctx context.Context, client *client.Clients) (*resources.Cache, error)
 
 var lastStatus string
 
+var (
+	reconnectionAttempts = telemetry.NewCounter("uds_runtime_reconnection_attempts_total",
+		"Number of attempts to reconnect to the cluster")
+	reconnections = telemetry.NewCounter("uds_runtime_reconnections_total",
+		"Number of successful reconnections to the cluster")
+	clusterConnected = telemetry.NewGauge("uds_runtime_cluster_connected",
+		"Whether the last cluster health check succeeded, 1 if it did")
+)
+
+var (
+	// ErrInCluster is returned when switching contexts while running in-cluster
+	ErrInCluster = errors.New("cannot switch contexts when running in-cluster")
+	// ErrContextNotFound is returned when switching to a context that is not in the kubeconfig
+	ErrContextNotFound = errors.New("context not found")
+)
+
 // CreateK8sSession creates a new k8s session
 func CreateK8sSession() (*K8sSession, error) {
 	k8sClient, err := client.NewClient()
@@ -74,7 +104,8 @@ func CreateK8sSession() (*K8sSession, error) {
 		Status:         make(chan string),
 		ready:          true,
 		createCache:    resources.NewCache,
-		createClient:   client.NewClient,
+		createClient:   client.NewClientForContext,
+		switched:       make(chan struct{}),
 	}
 
 	return session, nil
@@ -83,10 +114,12 @@ func CreateK8sSession() (*K8sSession, error) {
 func handleConnStatus(ks *K8sSession, err error) {
 	// Perform cluster health check
 	if err != nil {
+		clusterConnected.Set(0)
 		ks.Status <- "error"
 		lastStatus = "error"
 		ks.HandleReconnection()
 	} else {
+		clusterConnected.Set(1)
 		ks.Status <- "success"
 		lastStatus = "success"
 	}
@@ -98,45 +131,59 @@ func (ks *K8sSession) StartClusterMonitoring() {
 	defer ticker.Stop()
 
 	// Initial cluster health check
-	_, err := ks.Clients.Clientset.ServerVersion()
+	_, err := ks.GetClients().Clientset.ServerVersion()
 	handleConnStatus(ks, err)
 
 	for range ticker.C {
 		// Skip if not ready, e.g. during reconnection
-		if !ks.ready {
+		if !ks.isReady() {
 			continue
 		}
-		_, err := ks.Clients.Clientset.ServerVersion()
+		_, err := ks.GetClients().Clientset.ServerVersion()
 		handleConnStatus(ks, err)
 	}
 }
 
-// HandleReconnection infinitely retries to re-create the client and cache of the formerly connected cluster
+// HandleReconnection infinitely retries to re-create the client and cache of the formerly connected context
+// Reconnection stops if another context is switched to in the meantime
 func (ks *K8sSession) HandleReconnection() {
 	log.Println("Disconnected error received")
 
-	// Set ready to false to block cluster check ticker
+	// Set ready to false to block cluster check ticker and cancel the previous context
+	ks.mutex.Lock()
+	ks.stateMutex.Lock()
 	ks.ready = false
+	ks.stateMutex.Unlock()
+	ks.Cancel()
+	ks.mutex.Unlock()
 
 	for {
-		// Cancel the previous context
-		ks.Cancel()
 		time.Sleep(getRetryInterval())
 
-		currentCtx, currentCluster, err := client.GetCurrentContext()
+		ks.mutex.Lock()
+		if ks.ready {
+			ks.mutex.Unlock()
+			log.Println("Switched to another context. Stopping reconnection.")
+			break
+		}
+
+		// If the context was removed or now points to a different cluster, skip reconnection
+		currentCluster, err := contextCluster(ks.CurrentCtx)
 		if err != nil {
-			log.Printf("Error fetching current context: %v\n", err)
+			ks.mutex.Unlock()
+			log.Printf("Error fetching context %s: %v\n", ks.CurrentCtx, err)
 			continue
 		}
-
-		// If the current context or cluster is different from the original, skip reconnection
-		if currentCtx != ks.CurrentCtx || currentCluster != ks.CurrentCluster {
-			log.Println("Current context has changed. Skipping reconnection.")
+		if currentCluster != ks.CurrentCluster {
+			ks.mutex.Unlock()
+			log.Println("Cluster of the current context has changed. Skipping reconnection.")
 			continue
 		}
 
-		k8sClient, err := ks.createClient()
+		reconnectionAttempts.Inc()
+		k8sClient, err := ks.createClient(ks.CurrentCtx)
 		if err != nil {
+			ks.mutex.Unlock()
 			log.Printf("Retrying to create k8s client: %v\n", err)
 			continue
 		}
@@ -145,14 +192,21 @@ func (ks *K8sSession) HandleReconnection() {
 		ctx, cancel := context.WithCancel(context.Background())
 		cache, err := ks.createCache(ctx, k8sClient)
 		if err != nil {
+			cancel()
+			ks.mutex.Unlock()
 			log.Printf("Retrying to create cache: %v\n", err)
 			continue
 		}
 
+		ks.stateMutex.Lock()
 		ks.Clients = k8sClient
 		ks.Cache = cache
 		ks.Cancel = cancel
 		ks.ready = true
+		ks.stateMutex.Unlock()
+		ks.mutex.Unlock()
+		reconnections.Inc()
+		clusterConnected.Set(1)
 
 		// immediately send success status to client now that cache is recreated
 		ks.Status <- "success"
@@ -163,6 +217,106 @@ func (ks *K8sSession) HandleReconnection() {
 	}
 }
 
+// SwitchContext connects the session to another kubeconfig context, rebuilding the clients and cache
+// The previous cache is only stopped once the new one has synced, so requests are served throughout
+func (ks *K8sSession) SwitchContext(contextName string) error {
+	if ks.InCluster {
+		return ErrInCluster
+	}
+
+	ks.mutex.Lock()
+	defer ks.mutex.Unlock()
+
+	cluster, err := contextCluster(contextName)
+	if err != nil {
+		return err
+	}
+
+	k8sClient, err := ks.createClient(contextName)
+	if err != nil {
+		return fmt.Errorf("failed to create k8s client: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cache, err := ks.createCache(ctx, k8sClient)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to create cache: %w", err)
+	}
+
+	previousCancel := ks.Cancel
+	ks.stateMutex.Lock()
+	ks.Clients = k8sClient
+	ks.Cache = cache
+	ks.Cancel = cancel
+	ks.CurrentCtx = contextName
+	ks.CurrentCluster = cluster
+	ks.ready = true
+	ks.stateMutex.Unlock()
+	previousCancel()
+
+	// Notify open streams of the switch
+	ks.switchedMutex.Lock()
+	if ks.switched != nil {
+		close(ks.switched)
+	}
+	ks.switched = make(chan struct{})
+	ks.switchedMutex.Unlock()
+
+	log.Printf("Switched to context %s\n", contextName)
+	return nil
+}
+
+// GetClients returns the clients of the current context
+func (ks *K8sSession) GetClients() *client.Clients {
+	ks.stateMutex.RLock()
+	defer ks.stateMutex.RUnlock()
+	return ks.Clients
+}
+
+// GetCache returns the cache of the current context
+func (ks *K8sSession) GetCache() *resources.Cache {
+	ks.stateMutex.RLock()
+	defer ks.stateMutex.RUnlock()
+	return ks.Cache
+}
+
+// GetCurrentCtx returns the name of the current context
+func (ks *K8sSession) GetCurrentCtx() string {
+	ks.stateMutex.RLock()
+	defer ks.stateMutex.RUnlock()
+	return ks.CurrentCtx
+}
+
+// isReady returns whether the session is connected, it is not while reconnecting
+func (ks *K8sSession) isReady() bool {
+	ks.stateMutex.RLock()
+	defer ks.stateMutex.RUnlock()
+	return ks.ready
+}
+
+// Switched returns a channel that is closed when the session switches to another context
+func (ks *K8sSession) Switched() <-chan struct{} {
+	ks.switchedMutex.Lock()
+	defer ks.switchedMutex.Unlock()
+	return ks.switched
+}
+
+// contextCluster returns the cluster of the named kubeconfig context
+func contextCluster(contextName string) (string, error) {
+	contexts, err := client.GetContexts()
+	if err != nil {
+		return "", err
+	}
+
+	for _, kubeContext := range contexts {
+		if kubeContext.Name == contextName {
+			return kubeContext.Cluster, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrContextNotFound, contextName)
+}
+
 // getRetryInterval returns the interval to wait before retrying to connect to the k8s API
 func getRetryInterval() time.Duration {
 	if interval, exists := os.LookupEnv("CONNECTION_RETRY_MS"); exists {
@@ -212,3 +366,61 @@ func (ks *K8sSession) ServeConnStatus() http.HandlerFunc {
 		}
 	}
 }
+
+// ContextsResponse lists the kubeconfig contexts and the one the session is connected to
+type ContextsResponse struct {
+	Current  string               `json:"current"`
+	Contexts []client.KubeContext `json:"contexts"`
+}
+
+// ServeContexts returns a handler function that lists the kubeconfig contexts
+func (ks *K8sSession) ServeContexts() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		ks.writeContexts(w)
+	}
+}
+
+// ServeSwitchContext returns a handler function that switches the session to the context in the name query param
+func (ks *K8sSession) ServeSwitchContext() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		contextName := r.URL.Query().Get("name")
+		if contextName == "" {
+			http.Error(w, "Missing context name", http.StatusBadRequest)
+			return
+		}
+
+		if err := ks.SwitchContext(contextName); err != nil {
+			switch {
+			case errors.Is(err, ErrContextNotFound):
+				http.Error(w, err.Error(), http.StatusNotFound)
+			case errors.Is(err, ErrInCluster):
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			default:
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		ks.writeContexts(w)
+	}
+}
+
+// writeContexts writes the kubeconfig contexts and the current one as JSON
+func (ks *K8sSession) writeContexts(w http.ResponseWriter) {
+	response := ContextsResponse{Current: ks.GetCurrentCtx(), Contexts: []client.KubeContext{}}
+
+	// In-cluster there is no kubeconfig to list contexts from
+	if !ks.InCluster {
+		contexts, err := client.GetContexts()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read kubeconfig contexts: %v", err), http.StatusInternalServerError)
+			return
+		}
+		response.Contexts = contexts
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("Failed to encode contexts: %v\n", err)
+	}
+}
@@ -5,8 +5,10 @@ package session
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"os"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -16,16 +18,34 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
+// reconnect runs HandleReconnection until the test ends, it would otherwise keep retrying after the test
+func reconnect(t *testing.T, k8sSession *K8sSession) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		k8sSession.HandleReconnection()
+	}()
+
+	t.Cleanup(func() {
+		// Reconnection stops once the session is ready, as it does after a switch to another context
+		k8sSession.mutex.Lock()
+		k8sSession.stateMutex.Lock()
+		k8sSession.ready = true
+		k8sSession.stateMutex.Unlock()
+		k8sSession.mutex.Unlock()
+		<-done
+	})
+}
+
 func TestHandleReconnection(t *testing.T) {
-	os.Setenv("CONNECTION_RETRY_MS", "100")
-	defer os.Unsetenv("CONNECTION_RETRY_MS")
+	t.Setenv("CONNECTION_RETRY_MS", "100")
 
-	// Mock GetCurrentContext to return the same context and cluster as the original
-	client.GetCurrentContext = func() (string, string, error) {
-		return "original-context", "original-cluster", nil
+	// Mock GetContexts to return the original context pointing to the original cluster
+	client.GetContexts = func() ([]client.KubeContext, error) {
+		return []client.KubeContext{{Name: "original-context", Cluster: "original-cluster"}}, nil
 	}
 
-	createClientMock := func() (*client.Clients, error) {
+	createClientMock := func(_ string) (*client.Clients, error) {
 		return &client.Clients{Clientset: &kubernetes.Clientset{}}, nil
 	}
 
@@ -39,35 +59,35 @@ func TestHandleReconnection(t *testing.T) {
 		Cancel:         func() {},
 		CurrentCtx:     "original-context",
 		CurrentCluster: "original-cluster",
+		Status:         make(chan string, 1),
 		createCache:    createCacheMock,
 		createClient:   createClientMock,
 	}
 
-	require.Nil(t, k8sSession.Clients.Clientset)
-	require.Nil(t, k8sSession.Cache.Pods)
+	require.Nil(t, k8sSession.GetClients().Clientset)
+	require.Nil(t, k8sSession.GetCache().Pods)
 
 	// Run the handleReconnection function in a goroutine
-	go k8sSession.HandleReconnection()
+	reconnect(t, k8sSession)
 
 	// Wait for the reconnection logic to complete
 	time.Sleep(200 * time.Millisecond)
 
 	// Verify that the K8sResources struct was updated
-	require.NotNil(t, k8sSession.Clients.Clientset)
-	require.NotNil(t, k8sSession.Cache.Pods)
+	require.NotNil(t, k8sSession.GetClients().Clientset)
+	require.NotNil(t, k8sSession.GetCache().Pods)
 }
 
 // Test createClient returns an error
 func TestHandleReconnectionCreateClientError(t *testing.T) {
-	os.Setenv("CONNECTION_RETRY_MS", "100")
-	defer os.Unsetenv("CONNECTION_RETRY_MS")
+	t.Setenv("CONNECTION_RETRY_MS", "100")
 
-	// Mock GetCurrentContext to return the same context and cluster as the original
-	client.GetCurrentContext = func() (string, string, error) {
-		return "original-context", "original-cluster", nil
+	// Mock GetContexts to return the original context pointing to the original cluster
+	client.GetContexts = func() ([]client.KubeContext, error) {
+		return []client.KubeContext{{Name: "original-context", Cluster: "original-cluster"}}, nil
 	}
 
-	createClientMock := func() (*client.Clients, error) {
+	createClientMock := func(_ string) (*client.Clients, error) {
 
 		return nil, fmt.Errorf("failed to create client")
 	}
@@ -87,26 +107,25 @@ func TestHandleReconnectionCreateClientError(t *testing.T) {
 	}
 
 	// Run the handleReconnection function in a goroutine
-	go k8sSession.HandleReconnection()
+	reconnect(t, k8sSession)
 
 	// Wait for the reconnection logic to attempt creating the client
 	time.Sleep(200 * time.Millisecond)
 
-	require.Nil(t, k8sSession.Clients.Clientset)
-	require.Nil(t, k8sSession.Cache.Pods)
+	require.Nil(t, k8sSession.GetClients().Clientset)
+	require.Nil(t, k8sSession.GetCache().Pods)
 }
 
 // Test createCache returns an error
 func TestHandleReconnectionCreateCacheError(t *testing.T) {
-	os.Setenv("CONNECTION_RETRY_MS", "100")
-	defer os.Unsetenv("CONNECTION_RETRY_MS")
+	t.Setenv("CONNECTION_RETRY_MS", "100")
 
-	// Mock GetCurrentContext to return the same context and cluster as the original
-	client.GetCurrentContext = func() (string, string, error) {
-		return "original-context", "original-cluster", nil
+	// Mock GetContexts to return the original context pointing to the original cluster
+	client.GetContexts = func() ([]client.KubeContext, error) {
+		return []client.KubeContext{{Name: "original-context", Cluster: "original-cluster"}}, nil
 	}
 
-	createClientMock := func() (*client.Clients, error) {
+	createClientMock := func(_ string) (*client.Clients, error) {
 		return &client.Clients{Clientset: &kubernetes.Clientset{}}, nil
 	}
 
@@ -125,26 +144,25 @@ func TestHandleReconnectionCreateCacheError(t *testing.T) {
 	}
 
 	// Run the handleReconnection function in a goroutine
-	go k8sSession.HandleReconnection()
+	reconnect(t, k8sSession)
 
 	// Wait for the reconnection logic to complete
 	time.Sleep(200 * time.Millisecond)
 
 	// Verify that the K8sResources cache was not updated since cache creation failed
-	require.Nil(t, k8sSession.Clients.Clientset)
-	require.Nil(t, k8sSession.Cache.Pods)
+	require.Nil(t, k8sSession.GetClients().Clientset)
+	require.Nil(t, k8sSession.GetCache().Pods)
 }
 
 func TestHandleReconnectionContextChanged(t *testing.T) {
-	os.Setenv("CONNECTION_RETRY_MS", "100")
-	defer os.Unsetenv("CONNECTION_RETRY_MS")
+	t.Setenv("CONNECTION_RETRY_MS", "100")
 
-	// Mock GetCurrentContext to return a different context and cluster
-	client.GetCurrentContext = func() (string, string, error) {
-		return "new-context", "new-cluster", nil
+	// Mock GetContexts to return the original context pointing to a different cluster
+	client.GetContexts = func() ([]client.KubeContext, error) {
+		return []client.KubeContext{{Name: "original-context", Cluster: "new-cluster"}}, nil
 	}
 
-	createClientMock := func() (*client.Clients, error) {
+	createClientMock := func(_ string) (*client.Clients, error) {
 		return &client.Clients{Clientset: &kubernetes.Clientset{}}, nil
 	}
 
@@ -163,12 +181,187 @@ func TestHandleReconnectionContextChanged(t *testing.T) {
 	}
 
 	// Run the handleReconnection function in a goroutine
-	go k8sSession.HandleReconnection()
+	reconnect(t, k8sSession)
 
 	// Wait for the reconnection logic to complete
 	time.Sleep(200 * time.Millisecond)
 
-	// Verify that the K8sResources struct was not updated since the context/cluster has changed
-	require.Nil(t, k8sSession.Clients.Clientset)
-	require.Nil(t, k8sSession.Cache.Pods)
+	// Verify that the K8sResources struct was not updated since the cluster has changed
+	require.Nil(t, k8sSession.GetClients().Clientset)
+	require.Nil(t, k8sSession.GetCache().Pods)
+}
+
+func TestSwitchContext(t *testing.T) {
+	client.GetContexts = func() ([]client.KubeContext, error) {
+		return []client.KubeContext{
+			{Name: "original-context", Cluster: "original-cluster"},
+			{Name: "other-context", Cluster: "other-cluster"},
+		}, nil
+	}
+
+	var requestedContext string
+	createClientMock := func(contextName string) (*client.Clients, error) {
+		requestedContext = contextName
+		return &client.Clients{Clientset: &kubernetes.Clientset{}}, nil
+	}
+
+	createCacheMock := func(ctx context.Context, client *client.Clients) (*resources.Cache, error) {
+		return &resources.Cache{Pods: &resources.ResourceList{}}, nil
+	}
+
+	previousCanceled := false
+	k8sSession := &K8sSession{
+		Clients:        &client.Clients{},
+		Cache:          &resources.Cache{},
+		Cancel:         func() { previousCanceled = true },
+		CurrentCtx:     "original-context",
+		CurrentCluster: "original-cluster",
+		createCache:    createCacheMock,
+		createClient:   createClientMock,
+		switched:       make(chan struct{}),
+	}
+	switched := k8sSession.Switched()
+
+	require.NoError(t, k8sSession.SwitchContext("other-context"))
+
+	require.Equal(t, "other-context", requestedContext)
+	require.Equal(t, "other-context", k8sSession.GetCurrentCtx())
+	require.Equal(t, "other-cluster", k8sSession.CurrentCluster)
+	require.NotNil(t, k8sSession.GetClients().Clientset)
+	require.NotNil(t, k8sSession.GetCache().Pods)
+	require.True(t, previousCanceled)
+
+	// Streams opened before the switch are notified, later ones wait for the next switch
+	select {
+	case <-switched:
+	case <-time.After(time.Second):
+		t.Fatal("expected open streams to be notified of the switch")
+	}
+	select {
+	case <-k8sSession.Switched():
+		t.Fatal("expected the new switched channel to be open")
+	default:
+	}
+}
+
+func TestSwitchContextErrors(t *testing.T) {
+	client.GetContexts = func() ([]client.KubeContext, error) {
+		return []client.KubeContext{{Name: "original-context", Cluster: "original-cluster"}}, nil
+	}
+
+	tests := []struct {
+		name        string
+		context     string
+		inCluster   bool
+		createCache createCache
+		expectedErr error
+	}{
+		{
+			name:        "Unknown context",
+			context:     "missing-context",
+			expectedErr: ErrContextNotFound,
+		},
+		{
+			name:        "Running in-cluster",
+			context:     "original-context",
+			inCluster:   true,
+			expectedErr: ErrInCluster,
+		},
+		{
+			name:    "Cache creation fails",
+			context: "original-context",
+			createCache: func(ctx context.Context, client *client.Clients) (*resources.Cache, error) {
+				return nil, fmt.Errorf("failed to create cache")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSession := &K8sSession{
+				Clients:        &client.Clients{},
+				Cache:          &resources.Cache{},
+				Cancel:         func() { t.Fatal("the previous cache should not be canceled") },
+				CurrentCtx:     "original-context",
+				CurrentCluster: "original-cluster",
+				InCluster:      tt.inCluster,
+				createCache:    tt.createCache,
+				createClient: func(_ string) (*client.Clients, error) {
+					return &client.Clients{Clientset: &kubernetes.Clientset{}}, nil
+				},
+			}
+
+			err := k8sSession.SwitchContext(tt.context)
+			require.Error(t, err)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			}
+
+			// The session is left untouched
+			require.Equal(t, "original-context", k8sSession.GetCurrentCtx())
+			require.Nil(t, k8sSession.GetClients().Clientset)
+		})
+	}
+}
+
+func TestServeSwitchContext(t *testing.T) {
+	client.GetContexts = func() ([]client.KubeContext, error) {
+		return []client.KubeContext{
+			{Name: "original-context", Cluster: "original-cluster"},
+			{Name: "other-context", Cluster: "other-cluster"},
+		}, nil
+	}
+
+	tests := []struct {
+		name            string
+		url             string
+		expectedStatus  int
+		expectedCurrent string
+	}{
+		{
+			name:            "Switch to another context",
+			url:             "/contexts/switch?name=other-context",
+			expectedStatus:  http.StatusOK,
+			expectedCurrent: "other-context",
+		},
+		{
+			name:           "Missing context name",
+			url:            "/contexts/switch",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unknown context",
+			url:            "/contexts/switch?name=missing-context",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSession := &K8sSession{
+				Clients:        &client.Clients{},
+				Cache:          &resources.Cache{},
+				Cancel:         func() {},
+				CurrentCtx:     "original-context",
+				CurrentCluster: "original-cluster",
+				createCache: func(ctx context.Context, client *client.Clients) (*resources.Cache, error) {
+					return &resources.Cache{Pods: &resources.ResourceList{}}, nil
+				},
+				createClient: func(_ string) (*client.Clients, error) {
+					return &client.Clients{Clientset: &kubernetes.Clientset{}}, nil
+				},
+			}
+
+			rr := httptest.NewRecorder()
+			k8sSession.ServeSwitchContext()(rr, httptest.NewRequest(http.MethodPost, tt.url, nil))
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response ContextsResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				require.Equal(t, tt.expectedCurrent, response.Current)
+				require.Len(t, response.Contexts, 2)
+			}
+		})
+	}
 }
@@ -34,8 +34,14 @@ type Stream struct {
 	reader     Reader
 	Follow     bool
 	Timestamps bool
-	Namespace  string
-	Since      time.Duration
+	Previous   bool
+	// Prefix each log line with the pod and container it came from
+	Prefix        bool
+	Namespace     string
+	LabelSelector string
+	FieldSelector string
+	Since         time.Duration
+	TailLines     *int64
 	// Adding for testability :-<
 	Client kubernetes.Interface
 }
@@ -60,7 +66,10 @@ func (s *Stream) Start(ctx context.Context) error {
 	}
 
 	// List the pods in the specified namespace
-	pods, err := s.Client.CoreV1().Pods(s.Namespace).List(context.TODO(), v1.ListOptions{})
+	pods, err := s.Client.CoreV1().Pods(s.Namespace).List(context.TODO(), v1.ListOptions{
+		LabelSelector: s.LabelSelector,
+		FieldSelector: s.FieldSelector,
+	})
 	if err != nil {
 		return fmt.Errorf("unable to get pods: %v", err)
 	}
@@ -86,6 +95,8 @@ func (s *Stream) Start(ctx context.Context) error {
 				Follow:     s.Follow,
 				Container:  container,
 				Timestamps: s.Timestamps,
+				Previous:   s.Previous,
+				TailLines:  s.TailLines,
 			}
 
 			// Set the sinceSeconds option if provided
@@ -103,8 +114,14 @@ func (s *Stream) Start(ctx context.Context) error {
 			}
 			defer logStream.Close()
 
+			// Prefix the log lines with the pod and container if requested
+			var writer io.Writer = s.writer
+			if s.Prefix {
+				writer = &prefixWriter{writer: s.writer, prefix: []byte(fmt.Sprintf("[%s/%s] ", podName, container))}
+			}
+
 			// Process the log stream
-			if err := s.reader.LogStream(s.writer, logStream, s.Timestamps); err != nil {
+			if err := s.reader.LogStream(writer, logStream, s.Timestamps); err != nil {
 				message.WarnErrf(err, "Error streaming logs for pod %s", podName)
 			}
 		}(pod, container)
@@ -143,3 +160,20 @@ func (s *Stream) Start(ctx context.Context) error {
 
 	return nil
 }
+
+// prefixWriter prepends a prefix to every write, readers are expected to write one line at a time
+type prefixWriter struct {
+	writer io.Writer
+	prefix []byte
+}
+
+// Write writes the prefixed data to the underlying writer
+func (p *prefixWriter) Write(data []byte) (int, error) {
+	line := make([]byte, 0, len(p.prefix)+len(data))
+	line = append(line, p.prefix...)
+	line = append(line, data...)
+	if _, err := p.writer.Write(line); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package stream
+
+import (
+	"bufio"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// defaultContainerAnnotation is the annotation kubectl uses to pick a pod's default container
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// TextReader is a Reader that streams plain text log lines from any pod
+type TextReader struct {
+	// Container to stream logs from, the pod's default container is used if empty
+	Container string
+}
+
+// NewTextReader creates a new TextReader for the given container
+func NewTextReader(container string) *TextReader {
+	return &TextReader{
+		Container: container,
+	}
+}
+
+// PodFilter creates a map of pod and container names to pull logs from
+// Pods that do not have the requested container are skipped
+func (t *TextReader) PodFilter(pods []corev1.Pod) map[string]string {
+	containers := make(map[string]string)
+
+	for _, pod := range pods {
+		if t.Container == "" {
+			if container := defaultContainer(pod); container != "" {
+				containers[pod.Name] = container
+			}
+			continue
+		}
+
+		if hasContainer(pod, t.Container) {
+			containers[pod.Name] = t.Container
+		}
+	}
+
+	return containers
+}
+
+// hasContainer returns whether the pod has a container, init container or ephemeral container with the name
+func hasContainer(pod corev1.Pod, name string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == name {
+			return true
+		}
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// LogStream writes each log line to the writer unmodified
+func (t *TextReader) LogStream(writer io.Writer, logStream io.ReadCloser, _ bool) error {
+	scanner := bufio.NewScanner(logStream)
+	buf := make([]byte, 0, 5*1024*1024) // Allocate a 5 MB buffer to handle large log lines
+	scanner.Buffer(buf, cap(buf))       // Set the maximum token size
+
+	for scanner.Scan() {
+		if _, err := writer.Write(scanner.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// LogFlush is a no-op as lines are written as soon as they are read
+func (t *TextReader) LogFlush(_ io.Writer) {}
+
+// defaultContainer returns the container kubectl would pick for the pod
+func defaultContainer(pod corev1.Pod) string {
+	if name, ok := pod.Annotations[defaultContainerAnnotation]; ok {
+		return name
+	}
+
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+
+	return ""
+}
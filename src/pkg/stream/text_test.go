@@ -0,0 +1,94 @@
+// Copyright 2024 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTextReader_PodFilter(t *testing.T) {
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "pod1"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}}},
+		},
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "pod2", Annotations: map[string]string{defaultContainerAnnotation: "sidecar"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}}},
+		},
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "pod3"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "other"}}},
+		},
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "pod4"},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init"}},
+				Containers:     []corev1.Container{{Name: "app"}},
+			},
+		},
+	}
+
+	t.Run("Default container", func(t *testing.T) {
+		containers := NewTextReader("").PodFilter(pods)
+		require.Equal(t, map[string]string{"pod1": "app", "pod2": "sidecar", "pod3": "other", "pod4": "app"}, containers)
+	})
+
+	t.Run("Specific container", func(t *testing.T) {
+		containers := NewTextReader("sidecar").PodFilter(pods)
+		require.Equal(t, map[string]string{"pod1": "sidecar", "pod2": "sidecar"}, containers)
+	})
+
+	t.Run("Init container", func(t *testing.T) {
+		containers := NewTextReader("init").PodFilter(pods)
+		require.Equal(t, map[string]string{"pod4": "init"}, containers)
+	})
+}
+
+func TestTextReader_LogStream(t *testing.T) {
+	var lines []string
+	writer := writerFunc(func(p []byte) (int, error) {
+		lines = append(lines, string(p))
+		return len(p), nil
+	})
+
+	logs := io.NopCloser(strings.NewReader("line one\nline two\n"))
+	err := NewTextReader("").LogStream(writer, logs, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"line one", "line two"}, lines)
+}
+
+func TestStream_Prefix(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "pod1", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+	})
+
+	var writer bytes.Buffer
+	stream := NewStream(&writer, NewTextReader(""), "default")
+	stream.Client = client
+	stream.Prefix = true
+
+	err := stream.Start(context.TODO())
+	require.NoError(t, err)
+
+	// The fake client always returns "fake logs" as the log stream
+	require.Equal(t, "[pod1/app] fake logs", writer.String())
+}
+
+// writerFunc adapts a function to an io.Writer
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}